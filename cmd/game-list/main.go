@@ -9,10 +9,10 @@ import (
 	"os"
 	"time"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
 	"github.com/dimspell/gladiator/internal/app/logger"
+	"github.com/dimspell/gladiator/internal/backend/bsession"
 )
 
 const (
@@ -28,7 +28,10 @@ func main() {
 
 	gm := multiv1connect.NewGameServiceClient(httpClient, fmt.Sprintf("http://%s/grpc", consoleUri))
 
-	list, err := gm.ListGames(ctx, connect.NewRequest(&multiv1.ListGamesRequest{}))
+	// Use the token issued by the console on sign-in.
+	session := &bsession.Session{Token: os.Getenv("SESSION_TOKEN")}
+
+	list, err := gm.ListGames(ctx, bsession.NewRequest(session, &multiv1.ListGamesRequest{}))
 	if err != nil {
 		panic(err)
 	}
@@ -37,7 +40,7 @@ func main() {
 	fmt.Println("--------------------------------")
 
 	for _, g := range list.Msg.Games {
-		game, err := gm.GetGame(ctx, connect.NewRequest(&multiv1.GetGameRequest{
+		game, err := gm.GetGame(ctx, bsession.NewRequest(session, &multiv1.GetGameRequest{
 			GameRoomId: g.GetGameId(),
 		}))
 		if err != nil {
@@ -8,7 +8,6 @@ import (
 	"os"
 	"time"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
 	"github.com/dimspell/gladiator/internal/app/logger"
@@ -54,7 +53,7 @@ func main() {
 	px.NewUDPRedirect = redirect.NewNoop
 	px.NewTCPRedirect = redirect.NewLineReader
 
-	if err := session.ConnectOverWebsocket(ctx, user1, fmt.Sprintf("ws://%s/lobby", consoleUri)); err != nil {
+	if err := session.ConnectOverWebsocket(ctx, user1, os.Getenv("SESSION_TOKEN"), fmt.Sprintf("ws://%s/lobby", consoleUri)); err != nil {
 		slog.Error("failed to connect over websocket", logging.Error(err))
 		return
 	}
@@ -80,7 +79,7 @@ func main() {
 		}
 	}()
 
-	game, err := gm.CreateGame(ctx, connect.NewRequest(&multiv1.CreateGameRequest{
+	game, err := gm.CreateGame(ctx, bsession.NewRequest(session, &multiv1.CreateGameRequest{
 		GameName:      roomId,
 		Password:      "",
 		MapId:         multiv1.GameMap_AbandonedRealm,
@@ -9,7 +9,6 @@ import (
 	"os"
 	"time"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
 	"github.com/dimspell/gladiator/internal/app/logger"
@@ -61,7 +60,7 @@ func main() {
 	px.NewUDPRedirect = redirect.NewNoop
 	px.NewTCPRedirect = redirect.NewLineReader
 
-	if err := session.ConnectOverWebsocket(ctx, user2, fmt.Sprintf("ws://%s/lobby", consoleUri)); err != nil {
+	if err := session.ConnectOverWebsocket(ctx, user2, os.Getenv("SESSION_TOKEN"), fmt.Sprintf("ws://%s/lobby", consoleUri)); err != nil {
 		slog.Error("failed to connect over websocket", logging.Error(err))
 		return
 	}
@@ -87,7 +86,7 @@ func main() {
 		}
 	}()
 
-	game, err := gm.GetGame(ctx, connect.NewRequest(&multiv1.GetGameRequest{
+	game, err := gm.GetGame(ctx, bsession.NewRequest(session, &multiv1.GetGameRequest{
 		GameRoomId: roomId,
 	}))
 	if err != nil {
@@ -116,7 +115,7 @@ func main() {
 	}
 	slog.Info("got player address", "address", addr)
 
-	join, err := gm.JoinGame(ctx, connect.NewRequest(&multiv1.JoinGameRequest{
+	join, err := gm.JoinGame(ctx, bsession.NewRequest(session, &multiv1.JoinGameRequest{
 		UserId:     meUserId,
 		GameRoomId: roomId,
 		IpAddress:  "127.0.0.1",
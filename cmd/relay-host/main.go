@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,7 +11,6 @@ import (
 	"os"
 	"time"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
 	"github.com/dimspell/gladiator/internal/app/logger"
@@ -27,6 +27,8 @@ func main() {
 
 	px := &relay.ProxyRelay{
 		RelayServerAddr: "localhost:9999",
+		// Fingerprint of the relay certificate, see /.well-known/console.json.
+		Fingerprint: os.Getenv("RELAY_FINGERPRINT"),
 	}
 	session := bsession.NewSession(nil)
 	session.ID = "sid-1"
@@ -37,12 +39,15 @@ func main() {
 	proxyClient := px.Create(session).(*relay.Relay)
 	session.Proxy = proxyClient
 
+	// Use the relay key issued by the console on sign-in.
+	session.RelayKey, _ = base64.StdEncoding.DecodeString(os.Getenv("RELAY_KEY"))
+
 	ctx := context.TODO()
 
 	if err := session.ConnectOverWebsocket(ctx, &multiv1.User{
 		UserId:   session.UserID,
 		Username: session.Username,
-	}, fmt.Sprintf("ws://%s/lobby", "localhost:2137")); err != nil {
+	}, os.Getenv("SESSION_TOKEN"), fmt.Sprintf("ws://%s/lobby", "localhost:2137")); err != nil {
 		slog.Error("failed to connect over websocket", logging.Error(err))
 		return
 	}
@@ -82,7 +87,7 @@ func main() {
 
 	consoleUri := fmt.Sprintf("%s://%s/grpc", "http", "localhost:2137")
 	gameClient := multiv1connect.NewGameServiceClient(&http.Client{Timeout: 10 * time.Second}, consoleUri)
-	if _, err := gameClient.CreateGame(ctx, connect.NewRequest(&multiv1.CreateGameRequest{
+	if _, err := gameClient.CreateGame(ctx, bsession.NewRequest(session, &multiv1.CreateGameRequest{
 		GameName:      roomID,
 		Password:      "",
 		MapId:         multiv1.GameMap(1),
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -12,7 +13,6 @@ import (
 	"os"
 	"time"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
 	"github.com/dimspell/gladiator/internal/app/logger"
@@ -120,6 +120,7 @@ func main() {
 
 	px := &relay.ProxyRelay{
 		RelayServerAddr: "localhost:9999",
+		Fingerprint:     os.Getenv("RELAY_FINGERPRINT"),
 		IPPrefix:        user.IPPrefix,
 	}
 
@@ -133,12 +134,15 @@ func main() {
 	session.Proxy = proxyClient
 	session.Conn = &mockConn{}
 
+	// Use the relay key issued by the console on sign-in.
+	session.RelayKey, _ = base64.StdEncoding.DecodeString(os.Getenv("RELAY_KEY"))
+
 	ctx := context.TODO()
 
 	if err := session.ConnectOverWebsocket(ctx, &multiv1.User{
 		UserId:   session.UserID,
 		Username: session.Username,
-	}, fmt.Sprintf("ws://%s/lobby", "localhost:2137")); err != nil {
+	}, os.Getenv("SESSION_TOKEN"), fmt.Sprintf("ws://%s/lobby", "localhost:2137")); err != nil {
 		slog.Error("failed to connect over websocket", logging.Error(err))
 		return
 	}
@@ -167,7 +171,7 @@ func main() {
 	consoleUri := fmt.Sprintf("%s://%s/grpc", "http", "localhost:2137")
 	gameClient := multiv1connect.NewGameServiceClient(&http.Client{Timeout: 10 * time.Second}, consoleUri)
 
-	gameRes, err := gameClient.GetGame(ctx, connect.NewRequest(&multiv1.GetGameRequest{
+	gameRes, err := gameClient.GetGame(ctx, bsession.NewRequest(session, &multiv1.GetGameRequest{
 		GameRoomId: roomID,
 	}))
 	if err != nil {
@@ -183,7 +187,7 @@ func main() {
 		return
 	}
 
-	if _, err := gameClient.JoinGame(ctx, connect.NewRequest(&multiv1.JoinGameRequest{
+	if _, err := gameClient.JoinGame(ctx, bsession.NewRequest(session, &multiv1.JoinGameRequest{
 		UserId:     session.UserID,
 		GameRoomId: roomID,
 		IpAddress:  "127.0.0.1",
@@ -201,6 +205,16 @@ func main() {
 		return
 	}
 
+	if _, err := session.Proxy.ConnectToPlayer(ctx, proxy.GetPlayerAddrParams{
+		GameID:     roomID,
+		UserID:     1,
+		IPAddress:  "127.0.0.2",
+		HostUserID: "1",
+	}); err != nil {
+		slog.Error("ConnectToPlayer", logging.Error(err))
+		return
+	}
+
 	r := chi.NewRouter()
 	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
 		v := State{
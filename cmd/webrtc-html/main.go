@@ -134,7 +134,7 @@ func main() {
 		}
 	}
 
-	if err := session.ConnectOverWebsocket(ctx, &v1.User{UserId: session.UserID, Username: session.Username}, wsURL); err != nil {
+	if err := session.ConnectOverWebsocket(ctx, &v1.User{UserId: session.UserID, Username: session.Username}, os.Getenv("SESSION_TOKEN"), wsURL); err != nil {
 		log.Fatal(err)
 	}
 
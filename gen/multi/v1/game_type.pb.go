@@ -20,6 +20,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// GameState is the stage of the game room lifecycle. The players can join
+// only the open game rooms.
+type GameState int32
+
+const (
+	GameState_GameStateCreating   GameState = 0
+	GameState_GameStateOpen       GameState = 1
+	GameState_GameStateInProgress GameState = 2
+	GameState_GameStateClosing    GameState = 3
+	GameState_GameStateClosed     GameState = 4
+)
+
+// Enum value maps for GameState.
+var (
+	GameState_name = map[int32]string{
+		0: "GameStateCreating",
+		1: "GameStateOpen",
+		2: "GameStateInProgress",
+		3: "GameStateClosing",
+		4: "GameStateClosed",
+	}
+	GameState_value = map[string]int32{
+		"GameStateCreating":   0,
+		"GameStateOpen":       1,
+		"GameStateInProgress": 2,
+		"GameStateClosing":    3,
+		"GameStateClosed":     4,
+	}
+)
+
+func (x GameState) Enum() *GameState {
+	p := new(GameState)
+	*p = x
+	return p
+}
+
+func (x GameState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameState) Descriptor() protoreflect.EnumDescriptor {
+	return file_multi_v1_game_type_proto_enumTypes[0].Descriptor()
+}
+
+func (GameState) Type() protoreflect.EnumType {
+	return &file_multi_v1_game_type_proto_enumTypes[0]
+}
+
+func (x GameState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameState.Descriptor instead.
+func (GameState) EnumDescriptor() ([]byte, []int) {
+	return file_multi_v1_game_type_proto_rawDescGZIP(), []int{0}
+}
+
 type GameMap int32
 
 const (
@@ -59,11 +116,11 @@ func (x GameMap) String() string {
 }
 
 func (GameMap) Descriptor() protoreflect.EnumDescriptor {
-	return file_multi_v1_game_type_proto_enumTypes[0].Descriptor()
+	return file_multi_v1_game_type_proto_enumTypes[1].Descriptor()
 }
 
 func (GameMap) Type() protoreflect.EnumType {
-	return &file_multi_v1_game_type_proto_enumTypes[0]
+	return &file_multi_v1_game_type_proto_enumTypes[1]
 }
 
 func (x GameMap) Number() protoreflect.EnumNumber {
@@ -72,17 +129,27 @@ func (x GameMap) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GameMap.Descriptor instead.
 func (GameMap) EnumDescriptor() ([]byte, []int) {
-	return file_multi_v1_game_type_proto_rawDescGZIP(), []int{0}
+	return file_multi_v1_game_type_proto_rawDescGZIP(), []int{1}
 }
 
 type Game struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	MapId         GameMap                `protobuf:"varint,4,opt,name=map_id,json=mapId,proto3,enum=multi.v1.GameMap" json:"map_id,omitempty"`
-	HostUserId    int64                  `protobuf:"varint,5,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
-	HostIpAddress string                 `protobuf:"bytes,6,opt,name=host_ip_address,json=hostIpAddress,proto3" json:"host_ip_address,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// password is never returned by the console, has_password tells whether
+	// the players are asked for one.
+	//
+	// Deprecated: Marked as deprecated in multi/v1/game_type.proto.
+	Password      string  `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	MapId         GameMap `protobuf:"varint,4,opt,name=map_id,json=mapId,proto3,enum=multi.v1.GameMap" json:"map_id,omitempty"`
+	HostUserId    int64   `protobuf:"varint,5,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
+	HostIpAddress string  `protobuf:"bytes,6,opt,name=host_ip_address,json=hostIpAddress,proto3" json:"host_ip_address,omitempty"`
+	// Number of the players in the game room, the host included, and the
+	// maximum number of them.
+	PlayerCount   int32     `protobuf:"varint,7,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	MaxPlayers    int32     `protobuf:"varint,8,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	HasPassword   bool      `protobuf:"varint,9,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"`
+	State         GameState `protobuf:"varint,10,opt,name=state,proto3,enum=multi.v1.GameState" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +198,7 @@ func (x *Game) GetName() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in multi/v1/game_type.proto.
 func (x *Game) GetPassword() string {
 	if x != nil {
 		return x.Password
@@ -159,6 +227,34 @@ func (x *Game) GetHostIpAddress() string {
 	return ""
 }
 
+func (x *Game) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *Game) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *Game) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *Game) GetState() GameState {
+	if x != nil {
+		return x.State
+	}
+	return GameState_GameStateCreating
+}
+
 type Player struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -242,47 +338,64 @@ var file_multi_v1_game_type_proto_rawDesc = []byte{
 	0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x75, 0x6c, 0x74,
 	0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
 	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x01, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x02, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
 	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
 	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x12,
-	0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x6f, 0x73, 0x74,
-	0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x06, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61,
-	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0a,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73,
-	0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2a,
-	0x71, 0x0a, 0x07, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x63,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x68, 0x65, 0x6c, 0x74, 0x65, 0x72, 0x10, 0x00,
-	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x61,
-	0x6c, 0x6d, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x77, 0x6f, 0x72,
-	0x6c, 0x64, 0x52, 0x65, 0x74, 0x72, 0x65, 0x61, 0x74, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x46,
-	0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x4c, 0x61, 0x62, 0x79, 0x72, 0x69, 0x6e, 0x74, 0x68, 0x10, 0x03,
-	0x12, 0x10, 0x0a, 0x0c, 0x43, 0x72, 0x69, 0x6d, 0x73, 0x6f, 0x6e, 0x41, 0x73, 0x68, 0x65, 0x73,
-	0x10, 0x04, 0x42, 0x92, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x2e, 0x76, 0x31, 0x42, 0x0d, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x69, 0x6d, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2f, 0x67, 0x6c, 0x61, 0x64, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2f, 0x76, 0x31,
-	0x3b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02,
-	0x08, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x6d, 0x61, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x6d, 0x61,
+	0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x70,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0xb3, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x2a, 0x79, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x10, 0x04,
+	0x2a, 0x71, 0x0a, 0x07, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x68, 0x65, 0x6c, 0x74, 0x65, 0x72, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x52, 0x65, 0x74, 0x72, 0x65, 0x61, 0x74, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
+	0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x4c, 0x61, 0x62, 0x79, 0x72, 0x69, 0x6e, 0x74, 0x68, 0x10,
+	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x72, 0x69, 0x6d, 0x73, 0x6f, 0x6e, 0x41, 0x73, 0x68, 0x65,
+	0x73, 0x10, 0x04, 0x42, 0x92, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x69, 0x6d, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2f, 0x67, 0x6c, 0x61, 0x64, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2f, 0x76,
+	0x31, 0x3b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa,
+	0x02, 0x08, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -297,22 +410,24 @@ func file_multi_v1_game_type_proto_rawDescGZIP() []byte {
 	return file_multi_v1_game_type_proto_rawDescData
 }
 
-var file_multi_v1_game_type_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_multi_v1_game_type_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_multi_v1_game_type_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_multi_v1_game_type_proto_goTypes = []any{
-	(GameMap)(0),   // 0: multi.v1.GameMap
-	(*Game)(nil),   // 1: multi.v1.Game
-	(*Player)(nil), // 2: multi.v1.Player
-	(ClassType)(0), // 3: multi.v1.ClassType
+	(GameState)(0), // 0: multi.v1.GameState
+	(GameMap)(0),   // 1: multi.v1.GameMap
+	(*Game)(nil),   // 2: multi.v1.Game
+	(*Player)(nil), // 3: multi.v1.Player
+	(ClassType)(0), // 4: multi.v1.ClassType
 }
 var file_multi_v1_game_type_proto_depIdxs = []int32{
-	0, // 0: multi.v1.Game.map_id:type_name -> multi.v1.GameMap
-	3, // 1: multi.v1.Player.class_type:type_name -> multi.v1.ClassType
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1, // 0: multi.v1.Game.map_id:type_name -> multi.v1.GameMap
+	0, // 1: multi.v1.Game.state:type_name -> multi.v1.GameState
+	4, // 2: multi.v1.Player.class_type:type_name -> multi.v1.ClassType
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_multi_v1_game_type_proto_init() }
@@ -326,7 +441,7 @@ func file_multi_v1_game_type_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_multi_v1_game_type_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -0,0 +1,616 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: multi/v1/admin.proto
+
+package multiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "multi.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceListSessionsProcedure is the fully-qualified name of the AdminService's ListSessions
+	// RPC.
+	AdminServiceListSessionsProcedure = "/multi.v1.AdminService/ListSessions"
+	// AdminServiceListRoomsProcedure is the fully-qualified name of the AdminService's ListRooms RPC.
+	AdminServiceListRoomsProcedure = "/multi.v1.AdminService/ListRooms"
+	// AdminServiceDestroyRoomProcedure is the fully-qualified name of the AdminService's DestroyRoom
+	// RPC.
+	AdminServiceDestroyRoomProcedure = "/multi.v1.AdminService/DestroyRoom"
+	// AdminServiceKickUserProcedure is the fully-qualified name of the AdminService's KickUser RPC.
+	AdminServiceKickUserProcedure = "/multi.v1.AdminService/KickUser"
+	// AdminServiceBroadcastMessageProcedure is the fully-qualified name of the AdminService's
+	// BroadcastMessage RPC.
+	AdminServiceBroadcastMessageProcedure = "/multi.v1.AdminService/BroadcastMessage"
+	// AdminServiceDrainBackendsProcedure is the fully-qualified name of the AdminService's
+	// DrainBackends RPC.
+	AdminServiceDrainBackendsProcedure = "/multi.v1.AdminService/DrainBackends"
+	// AdminServiceSetChannelMOTDProcedure is the fully-qualified name of the AdminService's
+	// SetChannelMOTD RPC.
+	AdminServiceSetChannelMOTDProcedure = "/multi.v1.AdminService/SetChannelMOTD"
+	// AdminServiceCreateAnnouncementProcedure is the fully-qualified name of the AdminService's
+	// CreateAnnouncement RPC.
+	AdminServiceCreateAnnouncementProcedure = "/multi.v1.AdminService/CreateAnnouncement"
+	// AdminServiceListAnnouncementsProcedure is the fully-qualified name of the AdminService's
+	// ListAnnouncements RPC.
+	AdminServiceListAnnouncementsProcedure = "/multi.v1.AdminService/ListAnnouncements"
+	// AdminServiceDeleteAnnouncementProcedure is the fully-qualified name of the AdminService's
+	// DeleteAnnouncement RPC.
+	AdminServiceDeleteAnnouncementProcedure = "/multi.v1.AdminService/DeleteAnnouncement"
+	// AdminServiceCreateBanProcedure is the fully-qualified name of the AdminService's CreateBan RPC.
+	AdminServiceCreateBanProcedure = "/multi.v1.AdminService/CreateBan"
+	// AdminServiceListBansProcedure is the fully-qualified name of the AdminService's ListBans RPC.
+	AdminServiceListBansProcedure = "/multi.v1.AdminService/ListBans"
+	// AdminServiceLiftBanProcedure is the fully-qualified name of the AdminService's LiftBan RPC.
+	AdminServiceLiftBanProcedure = "/multi.v1.AdminService/LiftBan"
+	// AdminServiceSetUserRoleProcedure is the fully-qualified name of the AdminService's SetUserRole
+	// RPC.
+	AdminServiceSetUserRoleProcedure = "/multi.v1.AdminService/SetUserRole"
+	// AdminServiceListChatHistoryProcedure is the fully-qualified name of the AdminService's
+	// ListChatHistory RPC.
+	AdminServiceListChatHistoryProcedure = "/multi.v1.AdminService/ListChatHistory"
+	// AdminServiceMuteUserProcedure is the fully-qualified name of the AdminService's MuteUser RPC.
+	AdminServiceMuteUserProcedure = "/multi.v1.AdminService/MuteUser"
+	// AdminServiceUnmuteUserProcedure is the fully-qualified name of the AdminService's UnmuteUser RPC.
+	AdminServiceUnmuteUserProcedure = "/multi.v1.AdminService/UnmuteUser"
+	// AdminServiceListModerationLogProcedure is the fully-qualified name of the AdminService's
+	// ListModerationLog RPC.
+	AdminServiceListModerationLogProcedure = "/multi.v1.AdminService/ListModerationLog"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	adminServiceServiceDescriptor                  = v1.File_multi_v1_admin_proto.Services().ByName("AdminService")
+	adminServiceListSessionsMethodDescriptor       = adminServiceServiceDescriptor.Methods().ByName("ListSessions")
+	adminServiceListRoomsMethodDescriptor          = adminServiceServiceDescriptor.Methods().ByName("ListRooms")
+	adminServiceDestroyRoomMethodDescriptor        = adminServiceServiceDescriptor.Methods().ByName("DestroyRoom")
+	adminServiceKickUserMethodDescriptor           = adminServiceServiceDescriptor.Methods().ByName("KickUser")
+	adminServiceBroadcastMessageMethodDescriptor   = adminServiceServiceDescriptor.Methods().ByName("BroadcastMessage")
+	adminServiceDrainBackendsMethodDescriptor      = adminServiceServiceDescriptor.Methods().ByName("DrainBackends")
+	adminServiceSetChannelMOTDMethodDescriptor     = adminServiceServiceDescriptor.Methods().ByName("SetChannelMOTD")
+	adminServiceCreateAnnouncementMethodDescriptor = adminServiceServiceDescriptor.Methods().ByName("CreateAnnouncement")
+	adminServiceListAnnouncementsMethodDescriptor  = adminServiceServiceDescriptor.Methods().ByName("ListAnnouncements")
+	adminServiceDeleteAnnouncementMethodDescriptor = adminServiceServiceDescriptor.Methods().ByName("DeleteAnnouncement")
+	adminServiceCreateBanMethodDescriptor          = adminServiceServiceDescriptor.Methods().ByName("CreateBan")
+	adminServiceListBansMethodDescriptor           = adminServiceServiceDescriptor.Methods().ByName("ListBans")
+	adminServiceLiftBanMethodDescriptor            = adminServiceServiceDescriptor.Methods().ByName("LiftBan")
+	adminServiceSetUserRoleMethodDescriptor        = adminServiceServiceDescriptor.Methods().ByName("SetUserRole")
+	adminServiceListChatHistoryMethodDescriptor    = adminServiceServiceDescriptor.Methods().ByName("ListChatHistory")
+	adminServiceMuteUserMethodDescriptor           = adminServiceServiceDescriptor.Methods().ByName("MuteUser")
+	adminServiceUnmuteUserMethodDescriptor         = adminServiceServiceDescriptor.Methods().ByName("UnmuteUser")
+	adminServiceListModerationLogMethodDescriptor  = adminServiceServiceDescriptor.Methods().ByName("ListModerationLog")
+)
+
+// AdminServiceClient is a client for the multi.v1.AdminService service.
+type AdminServiceClient interface {
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	ListRooms(context.Context, *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error)
+	DestroyRoom(context.Context, *connect.Request[v1.DestroyRoomRequest]) (*connect.Response[v1.DestroyRoomResponse], error)
+	KickUser(context.Context, *connect.Request[v1.KickUserRequest]) (*connect.Response[v1.KickUserResponse], error)
+	BroadcastMessage(context.Context, *connect.Request[v1.BroadcastMessageRequest]) (*connect.Response[v1.BroadcastMessageResponse], error)
+	DrainBackends(context.Context, *connect.Request[v1.DrainBackendsRequest]) (*connect.Response[v1.DrainBackendsResponse], error)
+	SetChannelMOTD(context.Context, *connect.Request[v1.SetChannelMOTDRequest]) (*connect.Response[v1.SetChannelMOTDResponse], error)
+	CreateAnnouncement(context.Context, *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error)
+	ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error)
+	DeleteAnnouncement(context.Context, *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error)
+	CreateBan(context.Context, *connect.Request[v1.CreateBanRequest]) (*connect.Response[v1.CreateBanResponse], error)
+	ListBans(context.Context, *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error)
+	LiftBan(context.Context, *connect.Request[v1.LiftBanRequest]) (*connect.Response[v1.LiftBanResponse], error)
+	SetUserRole(context.Context, *connect.Request[v1.SetUserRoleRequest]) (*connect.Response[v1.SetUserRoleResponse], error)
+	ListChatHistory(context.Context, *connect.Request[v1.ListChatHistoryRequest]) (*connect.Response[v1.ListChatHistoryResponse], error)
+	MuteUser(context.Context, *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error)
+	UnmuteUser(context.Context, *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error)
+	ListModerationLog(context.Context, *connect.Request[v1.ListModerationLogRequest]) (*connect.Response[v1.ListModerationLogResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the multi.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &adminServiceClient{
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+AdminServiceListSessionsProcedure,
+			connect.WithSchema(adminServiceListSessionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listRooms: connect.NewClient[v1.ListRoomsRequest, v1.ListRoomsResponse](
+			httpClient,
+			baseURL+AdminServiceListRoomsProcedure,
+			connect.WithSchema(adminServiceListRoomsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		destroyRoom: connect.NewClient[v1.DestroyRoomRequest, v1.DestroyRoomResponse](
+			httpClient,
+			baseURL+AdminServiceDestroyRoomProcedure,
+			connect.WithSchema(adminServiceDestroyRoomMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		kickUser: connect.NewClient[v1.KickUserRequest, v1.KickUserResponse](
+			httpClient,
+			baseURL+AdminServiceKickUserProcedure,
+			connect.WithSchema(adminServiceKickUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		broadcastMessage: connect.NewClient[v1.BroadcastMessageRequest, v1.BroadcastMessageResponse](
+			httpClient,
+			baseURL+AdminServiceBroadcastMessageProcedure,
+			connect.WithSchema(adminServiceBroadcastMessageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		drainBackends: connect.NewClient[v1.DrainBackendsRequest, v1.DrainBackendsResponse](
+			httpClient,
+			baseURL+AdminServiceDrainBackendsProcedure,
+			connect.WithSchema(adminServiceDrainBackendsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setChannelMOTD: connect.NewClient[v1.SetChannelMOTDRequest, v1.SetChannelMOTDResponse](
+			httpClient,
+			baseURL+AdminServiceSetChannelMOTDProcedure,
+			connect.WithSchema(adminServiceSetChannelMOTDMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createAnnouncement: connect.NewClient[v1.CreateAnnouncementRequest, v1.CreateAnnouncementResponse](
+			httpClient,
+			baseURL+AdminServiceCreateAnnouncementProcedure,
+			connect.WithSchema(adminServiceCreateAnnouncementMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAnnouncements: connect.NewClient[v1.ListAnnouncementsRequest, v1.ListAnnouncementsResponse](
+			httpClient,
+			baseURL+AdminServiceListAnnouncementsProcedure,
+			connect.WithSchema(adminServiceListAnnouncementsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAnnouncement: connect.NewClient[v1.DeleteAnnouncementRequest, v1.DeleteAnnouncementResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteAnnouncementProcedure,
+			connect.WithSchema(adminServiceDeleteAnnouncementMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createBan: connect.NewClient[v1.CreateBanRequest, v1.CreateBanResponse](
+			httpClient,
+			baseURL+AdminServiceCreateBanProcedure,
+			connect.WithSchema(adminServiceCreateBanMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listBans: connect.NewClient[v1.ListBansRequest, v1.ListBansResponse](
+			httpClient,
+			baseURL+AdminServiceListBansProcedure,
+			connect.WithSchema(adminServiceListBansMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		liftBan: connect.NewClient[v1.LiftBanRequest, v1.LiftBanResponse](
+			httpClient,
+			baseURL+AdminServiceLiftBanProcedure,
+			connect.WithSchema(adminServiceLiftBanMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setUserRole: connect.NewClient[v1.SetUserRoleRequest, v1.SetUserRoleResponse](
+			httpClient,
+			baseURL+AdminServiceSetUserRoleProcedure,
+			connect.WithSchema(adminServiceSetUserRoleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listChatHistory: connect.NewClient[v1.ListChatHistoryRequest, v1.ListChatHistoryResponse](
+			httpClient,
+			baseURL+AdminServiceListChatHistoryProcedure,
+			connect.WithSchema(adminServiceListChatHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		muteUser: connect.NewClient[v1.MuteUserRequest, v1.MuteUserResponse](
+			httpClient,
+			baseURL+AdminServiceMuteUserProcedure,
+			connect.WithSchema(adminServiceMuteUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unmuteUser: connect.NewClient[v1.UnmuteUserRequest, v1.UnmuteUserResponse](
+			httpClient,
+			baseURL+AdminServiceUnmuteUserProcedure,
+			connect.WithSchema(adminServiceUnmuteUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listModerationLog: connect.NewClient[v1.ListModerationLogRequest, v1.ListModerationLogResponse](
+			httpClient,
+			baseURL+AdminServiceListModerationLogProcedure,
+			connect.WithSchema(adminServiceListModerationLogMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	listSessions       *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	listRooms          *connect.Client[v1.ListRoomsRequest, v1.ListRoomsResponse]
+	destroyRoom        *connect.Client[v1.DestroyRoomRequest, v1.DestroyRoomResponse]
+	kickUser           *connect.Client[v1.KickUserRequest, v1.KickUserResponse]
+	broadcastMessage   *connect.Client[v1.BroadcastMessageRequest, v1.BroadcastMessageResponse]
+	drainBackends      *connect.Client[v1.DrainBackendsRequest, v1.DrainBackendsResponse]
+	setChannelMOTD     *connect.Client[v1.SetChannelMOTDRequest, v1.SetChannelMOTDResponse]
+	createAnnouncement *connect.Client[v1.CreateAnnouncementRequest, v1.CreateAnnouncementResponse]
+	listAnnouncements  *connect.Client[v1.ListAnnouncementsRequest, v1.ListAnnouncementsResponse]
+	deleteAnnouncement *connect.Client[v1.DeleteAnnouncementRequest, v1.DeleteAnnouncementResponse]
+	createBan          *connect.Client[v1.CreateBanRequest, v1.CreateBanResponse]
+	listBans           *connect.Client[v1.ListBansRequest, v1.ListBansResponse]
+	liftBan            *connect.Client[v1.LiftBanRequest, v1.LiftBanResponse]
+	setUserRole        *connect.Client[v1.SetUserRoleRequest, v1.SetUserRoleResponse]
+	listChatHistory    *connect.Client[v1.ListChatHistoryRequest, v1.ListChatHistoryResponse]
+	muteUser           *connect.Client[v1.MuteUserRequest, v1.MuteUserResponse]
+	unmuteUser         *connect.Client[v1.UnmuteUserRequest, v1.UnmuteUserResponse]
+	listModerationLog  *connect.Client[v1.ListModerationLogRequest, v1.ListModerationLogResponse]
+}
+
+// ListSessions calls multi.v1.AdminService.ListSessions.
+func (c *adminServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// ListRooms calls multi.v1.AdminService.ListRooms.
+func (c *adminServiceClient) ListRooms(ctx context.Context, req *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error) {
+	return c.listRooms.CallUnary(ctx, req)
+}
+
+// DestroyRoom calls multi.v1.AdminService.DestroyRoom.
+func (c *adminServiceClient) DestroyRoom(ctx context.Context, req *connect.Request[v1.DestroyRoomRequest]) (*connect.Response[v1.DestroyRoomResponse], error) {
+	return c.destroyRoom.CallUnary(ctx, req)
+}
+
+// KickUser calls multi.v1.AdminService.KickUser.
+func (c *adminServiceClient) KickUser(ctx context.Context, req *connect.Request[v1.KickUserRequest]) (*connect.Response[v1.KickUserResponse], error) {
+	return c.kickUser.CallUnary(ctx, req)
+}
+
+// BroadcastMessage calls multi.v1.AdminService.BroadcastMessage.
+func (c *adminServiceClient) BroadcastMessage(ctx context.Context, req *connect.Request[v1.BroadcastMessageRequest]) (*connect.Response[v1.BroadcastMessageResponse], error) {
+	return c.broadcastMessage.CallUnary(ctx, req)
+}
+
+// DrainBackends calls multi.v1.AdminService.DrainBackends.
+func (c *adminServiceClient) DrainBackends(ctx context.Context, req *connect.Request[v1.DrainBackendsRequest]) (*connect.Response[v1.DrainBackendsResponse], error) {
+	return c.drainBackends.CallUnary(ctx, req)
+}
+
+// SetChannelMOTD calls multi.v1.AdminService.SetChannelMOTD.
+func (c *adminServiceClient) SetChannelMOTD(ctx context.Context, req *connect.Request[v1.SetChannelMOTDRequest]) (*connect.Response[v1.SetChannelMOTDResponse], error) {
+	return c.setChannelMOTD.CallUnary(ctx, req)
+}
+
+// CreateAnnouncement calls multi.v1.AdminService.CreateAnnouncement.
+func (c *adminServiceClient) CreateAnnouncement(ctx context.Context, req *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error) {
+	return c.createAnnouncement.CallUnary(ctx, req)
+}
+
+// ListAnnouncements calls multi.v1.AdminService.ListAnnouncements.
+func (c *adminServiceClient) ListAnnouncements(ctx context.Context, req *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error) {
+	return c.listAnnouncements.CallUnary(ctx, req)
+}
+
+// DeleteAnnouncement calls multi.v1.AdminService.DeleteAnnouncement.
+func (c *adminServiceClient) DeleteAnnouncement(ctx context.Context, req *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error) {
+	return c.deleteAnnouncement.CallUnary(ctx, req)
+}
+
+// CreateBan calls multi.v1.AdminService.CreateBan.
+func (c *adminServiceClient) CreateBan(ctx context.Context, req *connect.Request[v1.CreateBanRequest]) (*connect.Response[v1.CreateBanResponse], error) {
+	return c.createBan.CallUnary(ctx, req)
+}
+
+// ListBans calls multi.v1.AdminService.ListBans.
+func (c *adminServiceClient) ListBans(ctx context.Context, req *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error) {
+	return c.listBans.CallUnary(ctx, req)
+}
+
+// LiftBan calls multi.v1.AdminService.LiftBan.
+func (c *adminServiceClient) LiftBan(ctx context.Context, req *connect.Request[v1.LiftBanRequest]) (*connect.Response[v1.LiftBanResponse], error) {
+	return c.liftBan.CallUnary(ctx, req)
+}
+
+// SetUserRole calls multi.v1.AdminService.SetUserRole.
+func (c *adminServiceClient) SetUserRole(ctx context.Context, req *connect.Request[v1.SetUserRoleRequest]) (*connect.Response[v1.SetUserRoleResponse], error) {
+	return c.setUserRole.CallUnary(ctx, req)
+}
+
+// ListChatHistory calls multi.v1.AdminService.ListChatHistory.
+func (c *adminServiceClient) ListChatHistory(ctx context.Context, req *connect.Request[v1.ListChatHistoryRequest]) (*connect.Response[v1.ListChatHistoryResponse], error) {
+	return c.listChatHistory.CallUnary(ctx, req)
+}
+
+// MuteUser calls multi.v1.AdminService.MuteUser.
+func (c *adminServiceClient) MuteUser(ctx context.Context, req *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error) {
+	return c.muteUser.CallUnary(ctx, req)
+}
+
+// UnmuteUser calls multi.v1.AdminService.UnmuteUser.
+func (c *adminServiceClient) UnmuteUser(ctx context.Context, req *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error) {
+	return c.unmuteUser.CallUnary(ctx, req)
+}
+
+// ListModerationLog calls multi.v1.AdminService.ListModerationLog.
+func (c *adminServiceClient) ListModerationLog(ctx context.Context, req *connect.Request[v1.ListModerationLogRequest]) (*connect.Response[v1.ListModerationLogResponse], error) {
+	return c.listModerationLog.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the multi.v1.AdminService service.
+type AdminServiceHandler interface {
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	ListRooms(context.Context, *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error)
+	DestroyRoom(context.Context, *connect.Request[v1.DestroyRoomRequest]) (*connect.Response[v1.DestroyRoomResponse], error)
+	KickUser(context.Context, *connect.Request[v1.KickUserRequest]) (*connect.Response[v1.KickUserResponse], error)
+	BroadcastMessage(context.Context, *connect.Request[v1.BroadcastMessageRequest]) (*connect.Response[v1.BroadcastMessageResponse], error)
+	DrainBackends(context.Context, *connect.Request[v1.DrainBackendsRequest]) (*connect.Response[v1.DrainBackendsResponse], error)
+	SetChannelMOTD(context.Context, *connect.Request[v1.SetChannelMOTDRequest]) (*connect.Response[v1.SetChannelMOTDResponse], error)
+	CreateAnnouncement(context.Context, *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error)
+	ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error)
+	DeleteAnnouncement(context.Context, *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error)
+	CreateBan(context.Context, *connect.Request[v1.CreateBanRequest]) (*connect.Response[v1.CreateBanResponse], error)
+	ListBans(context.Context, *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error)
+	LiftBan(context.Context, *connect.Request[v1.LiftBanRequest]) (*connect.Response[v1.LiftBanResponse], error)
+	SetUserRole(context.Context, *connect.Request[v1.SetUserRoleRequest]) (*connect.Response[v1.SetUserRoleResponse], error)
+	ListChatHistory(context.Context, *connect.Request[v1.ListChatHistoryRequest]) (*connect.Response[v1.ListChatHistoryResponse], error)
+	MuteUser(context.Context, *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error)
+	UnmuteUser(context.Context, *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error)
+	ListModerationLog(context.Context, *connect.Request[v1.ListModerationLogRequest]) (*connect.Response[v1.ListModerationLogResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceListSessionsHandler := connect.NewUnaryHandler(
+		AdminServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(adminServiceListSessionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListRoomsHandler := connect.NewUnaryHandler(
+		AdminServiceListRoomsProcedure,
+		svc.ListRooms,
+		connect.WithSchema(adminServiceListRoomsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDestroyRoomHandler := connect.NewUnaryHandler(
+		AdminServiceDestroyRoomProcedure,
+		svc.DestroyRoom,
+		connect.WithSchema(adminServiceDestroyRoomMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceKickUserHandler := connect.NewUnaryHandler(
+		AdminServiceKickUserProcedure,
+		svc.KickUser,
+		connect.WithSchema(adminServiceKickUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceBroadcastMessageHandler := connect.NewUnaryHandler(
+		AdminServiceBroadcastMessageProcedure,
+		svc.BroadcastMessage,
+		connect.WithSchema(adminServiceBroadcastMessageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDrainBackendsHandler := connect.NewUnaryHandler(
+		AdminServiceDrainBackendsProcedure,
+		svc.DrainBackends,
+		connect.WithSchema(adminServiceDrainBackendsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetChannelMOTDHandler := connect.NewUnaryHandler(
+		AdminServiceSetChannelMOTDProcedure,
+		svc.SetChannelMOTD,
+		connect.WithSchema(adminServiceSetChannelMOTDMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateAnnouncementHandler := connect.NewUnaryHandler(
+		AdminServiceCreateAnnouncementProcedure,
+		svc.CreateAnnouncement,
+		connect.WithSchema(adminServiceCreateAnnouncementMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAnnouncementsHandler := connect.NewUnaryHandler(
+		AdminServiceListAnnouncementsProcedure,
+		svc.ListAnnouncements,
+		connect.WithSchema(adminServiceListAnnouncementsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteAnnouncementHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteAnnouncementProcedure,
+		svc.DeleteAnnouncement,
+		connect.WithSchema(adminServiceDeleteAnnouncementMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateBanHandler := connect.NewUnaryHandler(
+		AdminServiceCreateBanProcedure,
+		svc.CreateBan,
+		connect.WithSchema(adminServiceCreateBanMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListBansHandler := connect.NewUnaryHandler(
+		AdminServiceListBansProcedure,
+		svc.ListBans,
+		connect.WithSchema(adminServiceListBansMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceLiftBanHandler := connect.NewUnaryHandler(
+		AdminServiceLiftBanProcedure,
+		svc.LiftBan,
+		connect.WithSchema(adminServiceLiftBanMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetUserRoleHandler := connect.NewUnaryHandler(
+		AdminServiceSetUserRoleProcedure,
+		svc.SetUserRole,
+		connect.WithSchema(adminServiceSetUserRoleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListChatHistoryHandler := connect.NewUnaryHandler(
+		AdminServiceListChatHistoryProcedure,
+		svc.ListChatHistory,
+		connect.WithSchema(adminServiceListChatHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceMuteUserHandler := connect.NewUnaryHandler(
+		AdminServiceMuteUserProcedure,
+		svc.MuteUser,
+		connect.WithSchema(adminServiceMuteUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUnmuteUserHandler := connect.NewUnaryHandler(
+		AdminServiceUnmuteUserProcedure,
+		svc.UnmuteUser,
+		connect.WithSchema(adminServiceUnmuteUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListModerationLogHandler := connect.NewUnaryHandler(
+		AdminServiceListModerationLogProcedure,
+		svc.ListModerationLog,
+		connect.WithSchema(adminServiceListModerationLogMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/multi.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceListSessionsProcedure:
+			adminServiceListSessionsHandler.ServeHTTP(w, r)
+		case AdminServiceListRoomsProcedure:
+			adminServiceListRoomsHandler.ServeHTTP(w, r)
+		case AdminServiceDestroyRoomProcedure:
+			adminServiceDestroyRoomHandler.ServeHTTP(w, r)
+		case AdminServiceKickUserProcedure:
+			adminServiceKickUserHandler.ServeHTTP(w, r)
+		case AdminServiceBroadcastMessageProcedure:
+			adminServiceBroadcastMessageHandler.ServeHTTP(w, r)
+		case AdminServiceDrainBackendsProcedure:
+			adminServiceDrainBackendsHandler.ServeHTTP(w, r)
+		case AdminServiceSetChannelMOTDProcedure:
+			adminServiceSetChannelMOTDHandler.ServeHTTP(w, r)
+		case AdminServiceCreateAnnouncementProcedure:
+			adminServiceCreateAnnouncementHandler.ServeHTTP(w, r)
+		case AdminServiceListAnnouncementsProcedure:
+			adminServiceListAnnouncementsHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteAnnouncementProcedure:
+			adminServiceDeleteAnnouncementHandler.ServeHTTP(w, r)
+		case AdminServiceCreateBanProcedure:
+			adminServiceCreateBanHandler.ServeHTTP(w, r)
+		case AdminServiceListBansProcedure:
+			adminServiceListBansHandler.ServeHTTP(w, r)
+		case AdminServiceLiftBanProcedure:
+			adminServiceLiftBanHandler.ServeHTTP(w, r)
+		case AdminServiceSetUserRoleProcedure:
+			adminServiceSetUserRoleHandler.ServeHTTP(w, r)
+		case AdminServiceListChatHistoryProcedure:
+			adminServiceListChatHistoryHandler.ServeHTTP(w, r)
+		case AdminServiceMuteUserProcedure:
+			adminServiceMuteUserHandler.ServeHTTP(w, r)
+		case AdminServiceUnmuteUserProcedure:
+			adminServiceUnmuteUserHandler.ServeHTTP(w, r)
+		case AdminServiceListModerationLogProcedure:
+			adminServiceListModerationLogHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.ListSessions is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListRooms(context.Context, *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.ListRooms is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DestroyRoom(context.Context, *connect.Request[v1.DestroyRoomRequest]) (*connect.Response[v1.DestroyRoomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.DestroyRoom is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) KickUser(context.Context, *connect.Request[v1.KickUserRequest]) (*connect.Response[v1.KickUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.KickUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) BroadcastMessage(context.Context, *connect.Request[v1.BroadcastMessageRequest]) (*connect.Response[v1.BroadcastMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.BroadcastMessage is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DrainBackends(context.Context, *connect.Request[v1.DrainBackendsRequest]) (*connect.Response[v1.DrainBackendsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.DrainBackends is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetChannelMOTD(context.Context, *connect.Request[v1.SetChannelMOTDRequest]) (*connect.Response[v1.SetChannelMOTDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.SetChannelMOTD is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateAnnouncement(context.Context, *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.CreateAnnouncement is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.ListAnnouncements is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteAnnouncement(context.Context, *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.DeleteAnnouncement is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateBan(context.Context, *connect.Request[v1.CreateBanRequest]) (*connect.Response[v1.CreateBanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.CreateBan is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListBans(context.Context, *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.ListBans is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) LiftBan(context.Context, *connect.Request[v1.LiftBanRequest]) (*connect.Response[v1.LiftBanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.LiftBan is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetUserRole(context.Context, *connect.Request[v1.SetUserRoleRequest]) (*connect.Response[v1.SetUserRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.SetUserRole is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListChatHistory(context.Context, *connect.Request[v1.ListChatHistoryRequest]) (*connect.Response[v1.ListChatHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.ListChatHistory is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) MuteUser(context.Context, *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.MuteUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UnmuteUser(context.Context, *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.UnmuteUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListModerationLog(context.Context, *connect.Request[v1.ListModerationLogRequest]) (*connect.Response[v1.ListModerationLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.AdminService.ListModerationLog is not implemented"))
+}
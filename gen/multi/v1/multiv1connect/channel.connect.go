@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: multi/v1/channel.proto
+
+package multiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ChannelServiceName is the fully-qualified name of the ChannelService service.
+	ChannelServiceName = "multi.v1.ChannelService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ChannelServiceListChannelsProcedure is the fully-qualified name of the ChannelService's
+	// ListChannels RPC.
+	ChannelServiceListChannelsProcedure = "/multi.v1.ChannelService/ListChannels"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	channelServiceServiceDescriptor            = v1.File_multi_v1_channel_proto.Services().ByName("ChannelService")
+	channelServiceListChannelsMethodDescriptor = channelServiceServiceDescriptor.Methods().ByName("ListChannels")
+)
+
+// ChannelServiceClient is a client for the multi.v1.ChannelService service.
+type ChannelServiceClient interface {
+	ListChannels(context.Context, *connect.Request[v1.ListChannelsRequest]) (*connect.Response[v1.ListChannelsResponse], error)
+}
+
+// NewChannelServiceClient constructs a client for the multi.v1.ChannelService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewChannelServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ChannelServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &channelServiceClient{
+		listChannels: connect.NewClient[v1.ListChannelsRequest, v1.ListChannelsResponse](
+			httpClient,
+			baseURL+ChannelServiceListChannelsProcedure,
+			connect.WithSchema(channelServiceListChannelsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// channelServiceClient implements ChannelServiceClient.
+type channelServiceClient struct {
+	listChannels *connect.Client[v1.ListChannelsRequest, v1.ListChannelsResponse]
+}
+
+// ListChannels calls multi.v1.ChannelService.ListChannels.
+func (c *channelServiceClient) ListChannels(ctx context.Context, req *connect.Request[v1.ListChannelsRequest]) (*connect.Response[v1.ListChannelsResponse], error) {
+	return c.listChannels.CallUnary(ctx, req)
+}
+
+// ChannelServiceHandler is an implementation of the multi.v1.ChannelService service.
+type ChannelServiceHandler interface {
+	ListChannels(context.Context, *connect.Request[v1.ListChannelsRequest]) (*connect.Response[v1.ListChannelsResponse], error)
+}
+
+// NewChannelServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewChannelServiceHandler(svc ChannelServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	channelServiceListChannelsHandler := connect.NewUnaryHandler(
+		ChannelServiceListChannelsProcedure,
+		svc.ListChannels,
+		connect.WithSchema(channelServiceListChannelsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/multi.v1.ChannelService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ChannelServiceListChannelsProcedure:
+			channelServiceListChannelsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedChannelServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedChannelServiceHandler struct{}
+
+func (UnimplementedChannelServiceHandler) ListChannels(context.Context, *connect.Request[v1.ListChannelsRequest]) (*connect.Response[v1.ListChannelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.ChannelService.ListChannels is not implemented"))
+}
@@ -41,15 +41,22 @@ const (
 	GameServiceCreateGameProcedure = "/multi.v1.GameService/CreateGame"
 	// GameServiceJoinGameProcedure is the fully-qualified name of the GameService's JoinGame RPC.
 	GameServiceJoinGameProcedure = "/multi.v1.GameService/JoinGame"
+	// GameServiceSetGameStateProcedure is the fully-qualified name of the GameService's SetGameState
+	// RPC.
+	GameServiceSetGameStateProcedure = "/multi.v1.GameService/SetGameState"
+	// GameServiceKickPlayerProcedure is the fully-qualified name of the GameService's KickPlayer RPC.
+	GameServiceKickPlayerProcedure = "/multi.v1.GameService/KickPlayer"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	gameServiceServiceDescriptor          = v1.File_multi_v1_game_proto.Services().ByName("GameService")
-	gameServiceGetGameMethodDescriptor    = gameServiceServiceDescriptor.Methods().ByName("GetGame")
-	gameServiceListGamesMethodDescriptor  = gameServiceServiceDescriptor.Methods().ByName("ListGames")
-	gameServiceCreateGameMethodDescriptor = gameServiceServiceDescriptor.Methods().ByName("CreateGame")
-	gameServiceJoinGameMethodDescriptor   = gameServiceServiceDescriptor.Methods().ByName("JoinGame")
+	gameServiceServiceDescriptor            = v1.File_multi_v1_game_proto.Services().ByName("GameService")
+	gameServiceGetGameMethodDescriptor      = gameServiceServiceDescriptor.Methods().ByName("GetGame")
+	gameServiceListGamesMethodDescriptor    = gameServiceServiceDescriptor.Methods().ByName("ListGames")
+	gameServiceCreateGameMethodDescriptor   = gameServiceServiceDescriptor.Methods().ByName("CreateGame")
+	gameServiceJoinGameMethodDescriptor     = gameServiceServiceDescriptor.Methods().ByName("JoinGame")
+	gameServiceSetGameStateMethodDescriptor = gameServiceServiceDescriptor.Methods().ByName("SetGameState")
+	gameServiceKickPlayerMethodDescriptor   = gameServiceServiceDescriptor.Methods().ByName("KickPlayer")
 )
 
 // GameServiceClient is a client for the multi.v1.GameService service.
@@ -58,6 +65,11 @@ type GameServiceClient interface {
 	ListGames(context.Context, *connect.Request[v1.ListGamesRequest]) (*connect.Response[v1.ListGamesResponse], error)
 	CreateGame(context.Context, *connect.Request[v1.CreateGameRequest]) (*connect.Response[v1.CreateGameResponse], error)
 	JoinGame(context.Context, *connect.Request[v1.JoinGameRequest]) (*connect.Response[v1.JoinGameResponse], error)
+	// SetGameState lets the host start the game, so nobody else can join it,
+	// or open it again. The backend does not call it, because it is not known
+	// which packet tells that the game has started, so it is manual-only.
+	SetGameState(context.Context, *connect.Request[v1.SetGameStateRequest]) (*connect.Response[v1.SetGameStateResponse], error)
+	KickPlayer(context.Context, *connect.Request[v1.KickPlayerRequest]) (*connect.Response[v1.KickPlayerResponse], error)
 }
 
 // NewGameServiceClient constructs a client for the multi.v1.GameService service. By default, it
@@ -94,15 +106,29 @@ func NewGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(gameServiceJoinGameMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setGameState: connect.NewClient[v1.SetGameStateRequest, v1.SetGameStateResponse](
+			httpClient,
+			baseURL+GameServiceSetGameStateProcedure,
+			connect.WithSchema(gameServiceSetGameStateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		kickPlayer: connect.NewClient[v1.KickPlayerRequest, v1.KickPlayerResponse](
+			httpClient,
+			baseURL+GameServiceKickPlayerProcedure,
+			connect.WithSchema(gameServiceKickPlayerMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // gameServiceClient implements GameServiceClient.
 type gameServiceClient struct {
-	getGame    *connect.Client[v1.GetGameRequest, v1.GetGameResponse]
-	listGames  *connect.Client[v1.ListGamesRequest, v1.ListGamesResponse]
-	createGame *connect.Client[v1.CreateGameRequest, v1.CreateGameResponse]
-	joinGame   *connect.Client[v1.JoinGameRequest, v1.JoinGameResponse]
+	getGame      *connect.Client[v1.GetGameRequest, v1.GetGameResponse]
+	listGames    *connect.Client[v1.ListGamesRequest, v1.ListGamesResponse]
+	createGame   *connect.Client[v1.CreateGameRequest, v1.CreateGameResponse]
+	joinGame     *connect.Client[v1.JoinGameRequest, v1.JoinGameResponse]
+	setGameState *connect.Client[v1.SetGameStateRequest, v1.SetGameStateResponse]
+	kickPlayer   *connect.Client[v1.KickPlayerRequest, v1.KickPlayerResponse]
 }
 
 // GetGame calls multi.v1.GameService.GetGame.
@@ -125,12 +151,27 @@ func (c *gameServiceClient) JoinGame(ctx context.Context, req *connect.Request[v
 	return c.joinGame.CallUnary(ctx, req)
 }
 
+// SetGameState calls multi.v1.GameService.SetGameState.
+func (c *gameServiceClient) SetGameState(ctx context.Context, req *connect.Request[v1.SetGameStateRequest]) (*connect.Response[v1.SetGameStateResponse], error) {
+	return c.setGameState.CallUnary(ctx, req)
+}
+
+// KickPlayer calls multi.v1.GameService.KickPlayer.
+func (c *gameServiceClient) KickPlayer(ctx context.Context, req *connect.Request[v1.KickPlayerRequest]) (*connect.Response[v1.KickPlayerResponse], error) {
+	return c.kickPlayer.CallUnary(ctx, req)
+}
+
 // GameServiceHandler is an implementation of the multi.v1.GameService service.
 type GameServiceHandler interface {
 	GetGame(context.Context, *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error)
 	ListGames(context.Context, *connect.Request[v1.ListGamesRequest]) (*connect.Response[v1.ListGamesResponse], error)
 	CreateGame(context.Context, *connect.Request[v1.CreateGameRequest]) (*connect.Response[v1.CreateGameResponse], error)
 	JoinGame(context.Context, *connect.Request[v1.JoinGameRequest]) (*connect.Response[v1.JoinGameResponse], error)
+	// SetGameState lets the host start the game, so nobody else can join it,
+	// or open it again. The backend does not call it, because it is not known
+	// which packet tells that the game has started, so it is manual-only.
+	SetGameState(context.Context, *connect.Request[v1.SetGameStateRequest]) (*connect.Response[v1.SetGameStateResponse], error)
+	KickPlayer(context.Context, *connect.Request[v1.KickPlayerRequest]) (*connect.Response[v1.KickPlayerResponse], error)
 }
 
 // NewGameServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -163,6 +204,18 @@ func NewGameServiceHandler(svc GameServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(gameServiceJoinGameMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServiceSetGameStateHandler := connect.NewUnaryHandler(
+		GameServiceSetGameStateProcedure,
+		svc.SetGameState,
+		connect.WithSchema(gameServiceSetGameStateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceKickPlayerHandler := connect.NewUnaryHandler(
+		GameServiceKickPlayerProcedure,
+		svc.KickPlayer,
+		connect.WithSchema(gameServiceKickPlayerMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/multi.v1.GameService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GameServiceGetGameProcedure:
@@ -173,6 +226,10 @@ func NewGameServiceHandler(svc GameServiceHandler, opts ...connect.HandlerOption
 			gameServiceCreateGameHandler.ServeHTTP(w, r)
 		case GameServiceJoinGameProcedure:
 			gameServiceJoinGameHandler.ServeHTTP(w, r)
+		case GameServiceSetGameStateProcedure:
+			gameServiceSetGameStateHandler.ServeHTTP(w, r)
+		case GameServiceKickPlayerProcedure:
+			gameServiceKickPlayerHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -197,3 +254,11 @@ func (UnimplementedGameServiceHandler) CreateGame(context.Context, *connect.Requ
 func (UnimplementedGameServiceHandler) JoinGame(context.Context, *connect.Request[v1.JoinGameRequest]) (*connect.Response[v1.JoinGameResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.GameService.JoinGame is not implemented"))
 }
+
+func (UnimplementedGameServiceHandler) SetGameState(context.Context, *connect.Request[v1.SetGameStateRequest]) (*connect.Response[v1.SetGameStateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.GameService.SetGameState is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) KickPlayer(context.Context, *connect.Request[v1.KickPlayerRequest]) (*connect.Response[v1.KickPlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.GameService.KickPlayer is not implemented"))
+}
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: multi/v1/match.proto
+
+package multiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MatchServiceName is the fully-qualified name of the MatchService service.
+	MatchServiceName = "multi.v1.MatchService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MatchServiceListMatchesProcedure is the fully-qualified name of the MatchService's ListMatches
+	// RPC.
+	MatchServiceListMatchesProcedure = "/multi.v1.MatchService/ListMatches"
+	// MatchServiceGetMatchProcedure is the fully-qualified name of the MatchService's GetMatch RPC.
+	MatchServiceGetMatchProcedure = "/multi.v1.MatchService/GetMatch"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	matchServiceServiceDescriptor           = v1.File_multi_v1_match_proto.Services().ByName("MatchService")
+	matchServiceListMatchesMethodDescriptor = matchServiceServiceDescriptor.Methods().ByName("ListMatches")
+	matchServiceGetMatchMethodDescriptor    = matchServiceServiceDescriptor.Methods().ByName("GetMatch")
+)
+
+// MatchServiceClient is a client for the multi.v1.MatchService service.
+type MatchServiceClient interface {
+	ListMatches(context.Context, *connect.Request[v1.ListMatchesRequest]) (*connect.Response[v1.ListMatchesResponse], error)
+	GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error)
+}
+
+// NewMatchServiceClient constructs a client for the multi.v1.MatchService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMatchServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MatchServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &matchServiceClient{
+		listMatches: connect.NewClient[v1.ListMatchesRequest, v1.ListMatchesResponse](
+			httpClient,
+			baseURL+MatchServiceListMatchesProcedure,
+			connect.WithSchema(matchServiceListMatchesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getMatch: connect.NewClient[v1.GetMatchRequest, v1.GetMatchResponse](
+			httpClient,
+			baseURL+MatchServiceGetMatchProcedure,
+			connect.WithSchema(matchServiceGetMatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// matchServiceClient implements MatchServiceClient.
+type matchServiceClient struct {
+	listMatches *connect.Client[v1.ListMatchesRequest, v1.ListMatchesResponse]
+	getMatch    *connect.Client[v1.GetMatchRequest, v1.GetMatchResponse]
+}
+
+// ListMatches calls multi.v1.MatchService.ListMatches.
+func (c *matchServiceClient) ListMatches(ctx context.Context, req *connect.Request[v1.ListMatchesRequest]) (*connect.Response[v1.ListMatchesResponse], error) {
+	return c.listMatches.CallUnary(ctx, req)
+}
+
+// GetMatch calls multi.v1.MatchService.GetMatch.
+func (c *matchServiceClient) GetMatch(ctx context.Context, req *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error) {
+	return c.getMatch.CallUnary(ctx, req)
+}
+
+// MatchServiceHandler is an implementation of the multi.v1.MatchService service.
+type MatchServiceHandler interface {
+	ListMatches(context.Context, *connect.Request[v1.ListMatchesRequest]) (*connect.Response[v1.ListMatchesResponse], error)
+	GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error)
+}
+
+// NewMatchServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMatchServiceHandler(svc MatchServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	matchServiceListMatchesHandler := connect.NewUnaryHandler(
+		MatchServiceListMatchesProcedure,
+		svc.ListMatches,
+		connect.WithSchema(matchServiceListMatchesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	matchServiceGetMatchHandler := connect.NewUnaryHandler(
+		MatchServiceGetMatchProcedure,
+		svc.GetMatch,
+		connect.WithSchema(matchServiceGetMatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/multi.v1.MatchService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MatchServiceListMatchesProcedure:
+			matchServiceListMatchesHandler.ServeHTTP(w, r)
+		case MatchServiceGetMatchProcedure:
+			matchServiceGetMatchHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMatchServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMatchServiceHandler struct{}
+
+func (UnimplementedMatchServiceHandler) ListMatches(context.Context, *connect.Request[v1.ListMatchesRequest]) (*connect.Response[v1.ListMatchesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.MatchService.ListMatches is not implemented"))
+}
+
+func (UnimplementedMatchServiceHandler) GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.MatchService.GetMatch is not implemented"))
+}
@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: multi/v1/social.proto
+
+package multiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SocialServiceName is the fully-qualified name of the SocialService service.
+	SocialServiceName = "multi.v1.SocialService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SocialServiceAddFriendProcedure is the fully-qualified name of the SocialService's AddFriend RPC.
+	SocialServiceAddFriendProcedure = "/multi.v1.SocialService/AddFriend"
+	// SocialServiceRemoveFriendProcedure is the fully-qualified name of the SocialService's
+	// RemoveFriend RPC.
+	SocialServiceRemoveFriendProcedure = "/multi.v1.SocialService/RemoveFriend"
+	// SocialServiceListFriendsProcedure is the fully-qualified name of the SocialService's ListFriends
+	// RPC.
+	SocialServiceListFriendsProcedure = "/multi.v1.SocialService/ListFriends"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	socialServiceServiceDescriptor            = v1.File_multi_v1_social_proto.Services().ByName("SocialService")
+	socialServiceAddFriendMethodDescriptor    = socialServiceServiceDescriptor.Methods().ByName("AddFriend")
+	socialServiceRemoveFriendMethodDescriptor = socialServiceServiceDescriptor.Methods().ByName("RemoveFriend")
+	socialServiceListFriendsMethodDescriptor  = socialServiceServiceDescriptor.Methods().ByName("ListFriends")
+)
+
+// SocialServiceClient is a client for the multi.v1.SocialService service.
+type SocialServiceClient interface {
+	AddFriend(context.Context, *connect.Request[v1.AddFriendRequest]) (*connect.Response[v1.AddFriendResponse], error)
+	RemoveFriend(context.Context, *connect.Request[v1.RemoveFriendRequest]) (*connect.Response[v1.RemoveFriendResponse], error)
+	ListFriends(context.Context, *connect.Request[v1.ListFriendsRequest]) (*connect.Response[v1.ListFriendsResponse], error)
+}
+
+// NewSocialServiceClient constructs a client for the multi.v1.SocialService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSocialServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SocialServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &socialServiceClient{
+		addFriend: connect.NewClient[v1.AddFriendRequest, v1.AddFriendResponse](
+			httpClient,
+			baseURL+SocialServiceAddFriendProcedure,
+			connect.WithSchema(socialServiceAddFriendMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeFriend: connect.NewClient[v1.RemoveFriendRequest, v1.RemoveFriendResponse](
+			httpClient,
+			baseURL+SocialServiceRemoveFriendProcedure,
+			connect.WithSchema(socialServiceRemoveFriendMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listFriends: connect.NewClient[v1.ListFriendsRequest, v1.ListFriendsResponse](
+			httpClient,
+			baseURL+SocialServiceListFriendsProcedure,
+			connect.WithSchema(socialServiceListFriendsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// socialServiceClient implements SocialServiceClient.
+type socialServiceClient struct {
+	addFriend    *connect.Client[v1.AddFriendRequest, v1.AddFriendResponse]
+	removeFriend *connect.Client[v1.RemoveFriendRequest, v1.RemoveFriendResponse]
+	listFriends  *connect.Client[v1.ListFriendsRequest, v1.ListFriendsResponse]
+}
+
+// AddFriend calls multi.v1.SocialService.AddFriend.
+func (c *socialServiceClient) AddFriend(ctx context.Context, req *connect.Request[v1.AddFriendRequest]) (*connect.Response[v1.AddFriendResponse], error) {
+	return c.addFriend.CallUnary(ctx, req)
+}
+
+// RemoveFriend calls multi.v1.SocialService.RemoveFriend.
+func (c *socialServiceClient) RemoveFriend(ctx context.Context, req *connect.Request[v1.RemoveFriendRequest]) (*connect.Response[v1.RemoveFriendResponse], error) {
+	return c.removeFriend.CallUnary(ctx, req)
+}
+
+// ListFriends calls multi.v1.SocialService.ListFriends.
+func (c *socialServiceClient) ListFriends(ctx context.Context, req *connect.Request[v1.ListFriendsRequest]) (*connect.Response[v1.ListFriendsResponse], error) {
+	return c.listFriends.CallUnary(ctx, req)
+}
+
+// SocialServiceHandler is an implementation of the multi.v1.SocialService service.
+type SocialServiceHandler interface {
+	AddFriend(context.Context, *connect.Request[v1.AddFriendRequest]) (*connect.Response[v1.AddFriendResponse], error)
+	RemoveFriend(context.Context, *connect.Request[v1.RemoveFriendRequest]) (*connect.Response[v1.RemoveFriendResponse], error)
+	ListFriends(context.Context, *connect.Request[v1.ListFriendsRequest]) (*connect.Response[v1.ListFriendsResponse], error)
+}
+
+// NewSocialServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSocialServiceHandler(svc SocialServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	socialServiceAddFriendHandler := connect.NewUnaryHandler(
+		SocialServiceAddFriendProcedure,
+		svc.AddFriend,
+		connect.WithSchema(socialServiceAddFriendMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	socialServiceRemoveFriendHandler := connect.NewUnaryHandler(
+		SocialServiceRemoveFriendProcedure,
+		svc.RemoveFriend,
+		connect.WithSchema(socialServiceRemoveFriendMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	socialServiceListFriendsHandler := connect.NewUnaryHandler(
+		SocialServiceListFriendsProcedure,
+		svc.ListFriends,
+		connect.WithSchema(socialServiceListFriendsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/multi.v1.SocialService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SocialServiceAddFriendProcedure:
+			socialServiceAddFriendHandler.ServeHTTP(w, r)
+		case SocialServiceRemoveFriendProcedure:
+			socialServiceRemoveFriendHandler.ServeHTTP(w, r)
+		case SocialServiceListFriendsProcedure:
+			socialServiceListFriendsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSocialServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSocialServiceHandler struct{}
+
+func (UnimplementedSocialServiceHandler) AddFriend(context.Context, *connect.Request[v1.AddFriendRequest]) (*connect.Response[v1.AddFriendResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.SocialService.AddFriend is not implemented"))
+}
+
+func (UnimplementedSocialServiceHandler) RemoveFriend(context.Context, *connect.Request[v1.RemoveFriendRequest]) (*connect.Response[v1.RemoveFriendResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.SocialService.RemoveFriend is not implemented"))
+}
+
+func (UnimplementedSocialServiceHandler) ListFriends(context.Context, *connect.Request[v1.ListFriendsRequest]) (*connect.Response[v1.ListFriendsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("multi.v1.SocialService.ListFriends is not implemented"))
+}
@@ -68,13 +68,29 @@ func selectProxy(c *cli.Command) (p backend.Proxy, err error) {
 			},
 		}, nil
 	case proxyTypeRelay:
-		relayAddr := c.String("relay-addr")
-		return &relay.ProxyRelay{RelayServerAddr: relayAddr}, nil
+		px := &relay.ProxyRelay{
+			RelayServerAddr: c.String("relay-addr"),
+			Fingerprint:     c.String("relay-fingerprint"),
+		}
+		if caFile := c.String("relay-ca-file"); caFile != "" {
+			px.RootCAs, err = relay.LoadCertPool(caFile)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return px, nil
 	default:
 		return nil, fmt.Errorf("unknown proxy: %q", c.String("proxy"))
 	}
 }
 
+func selectDrainPolicy(c *cli.Command) backend.DrainPolicy {
+	policy := backend.DefaultDrainPolicy
+	policy.Countdown = c.Duration("shutdown-countdown")
+	policy.SaveTimeout = c.Duration("shutdown-save-timeout")
+	return policy
+}
+
 func selectConsoleOptions(c *cli.Command, version string) ([]console.Option, error) {
 	var options []console.Option
 
@@ -89,6 +105,47 @@ func selectConsoleOptions(c *cli.Command, version string) ([]console.Option, err
 		options = append(options, console.WithRelayAddr(relayBindAddr, relayPublicAddr))
 	}
 
+	if sessionSecret := c.String("session-secret"); sessionSecret != "" {
+		options = append(options, console.WithSessionSecret(sessionSecret))
+	}
+	if adminSecret := c.String("admin-secret"); adminSecret != "" {
+		options = append(options, console.WithAdminSecret(adminSecret))
+	}
+	if passwordCost := c.Int("password-cost"); passwordCost != 0 {
+		options = append(options, console.WithPasswordCost(passwordCost))
+	}
+	if certFile, keyFile := c.String("tls-cert-file"), c.String("tls-key-file"); certFile != "" || keyFile != "" {
+		options = append(options, console.WithTLS(certFile, keyFile))
+	}
+	if certFile, keyFile := c.String("relay-tls-cert-file"), c.String("relay-tls-key-file"); certFile != "" || keyFile != "" {
+		options = append(options, console.WithRelayTLS(certFile, keyFile))
+	}
+	if reserved := c.StringSlice("reserved-names"); len(reserved) > 0 {
+		options = append(options, console.WithReservedNames(reserved))
+	}
+	if denyList := c.String("name-deny-list"); denyList != "" {
+		options = append(options, console.WithNameDenyList(denyList))
+	}
+	if motd := c.String("motd"); motd != "" {
+		options = append(options, console.WithMOTD(motd))
+	}
+	options = append(options, console.WithChatHistory(
+		c.Int("chat-replay"),
+		c.Int("chat-history-limit"),
+		c.Duration("chat-history-ttl"),
+	))
+	options = append(options, console.WithChatFlood(c.Int("chat-flood-burst"), c.Duration("chat-flood-interval")))
+	if filter := c.String("chat-filter"); filter != "" {
+		options = append(options, console.WithChatFilter(filter, console.FilterMode(c.String("chat-filter-mode"))))
+	}
+	options = append(options, console.WithRoomRestoreGrace(c.Duration("room-restore-grace")))
+	options = append(options, console.WithRoomReaper(
+		c.Duration("room-ready-timeout"),
+		c.Duration("room-host-timeout"),
+		c.Duration("room-idle-timeout"),
+	))
+	options = append(options, console.WithRoomKickBan(c.Duration("room-kick-ban")))
+
 	return options, nil
 }
 
@@ -0,0 +1,461 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
+	"github.com/dimspell/gladiator/internal/console"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/urfave/cli/v3"
+)
+
+func AdminCommand() *cli.Command {
+	cmd := &cli.Command{
+		Name:        "admin",
+		Description: "Manage a running console server",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "console-addr",
+				Value:   defaultPublicConsoleAddr,
+				Usage:   "Address to the console server (with http:// or https://)",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("CONSOLE_ADDR")),
+			},
+			&cli.StringFlag{
+				Name:     "admin-secret",
+				Usage:    "Admin secret configured in the console server",
+				Required: true,
+				Sources:  cli.NewValueSourceChain(cli.EnvVar("ADMIN_SECRET")),
+			},
+		},
+		Commands: []*cli.Command{
+			{
+				Name:  "sessions",
+				Usage: "List users connected to the lobby",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.ListSessions(ctx, connect.NewRequest(&multiv1.ListSessionsRequest{}))
+					if err != nil {
+						return err
+					}
+					for _, s := range resp.Msg.Sessions {
+						fmt.Printf("%d\t%s\t%s\tgame=%q\tconnected=%s\n",
+							s.UserId, s.Username, s.ClassType, s.GameRoomId,
+							time.Unix(s.ConnectedAt, 0).Format(time.RFC3339))
+					}
+					return nil
+				}),
+			},
+			{
+				Name:  "rooms",
+				Usage: "List game rooms",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.ListRooms(ctx, connect.NewRequest(&multiv1.ListRoomsRequest{}))
+					if err != nil {
+						return err
+					}
+					for _, room := range resp.Msg.Rooms {
+						players := make([]string, 0, len(room.Players))
+						for _, p := range room.Players {
+							players = append(players, p.Username)
+						}
+						fmt.Printf("%s\t%s\thost=%d\tstate=%s\tplayers=%s\n",
+							room.Game.GameId, room.Game.MapId, room.Game.HostUserId, room.Game.State,
+							strings.Join(players, ","))
+					}
+					return nil
+				}),
+			},
+			{
+				Name:      "destroy-room",
+				Usage:     "Destroy the game room",
+				ArgsUsage: "<game-room-id>",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					if c.Args().Len() != 1 {
+						return fmt.Errorf("expected exactly one game room ID")
+					}
+					_, err := client.DestroyRoom(ctx, connect.NewRequest(&multiv1.DestroyRoomRequest{
+						GameRoomId: c.Args().First(),
+					}))
+					return err
+				}),
+			},
+			{
+				Name:      "kick",
+				Usage:     "Disconnect the user from the lobby and the relay server",
+				ArgsUsage: "<user-id> [reason]",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					userID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid user ID: %w", err)
+					}
+					_, err = client.KickUser(ctx, connect.NewRequest(&multiv1.KickUserRequest{
+						UserId: userID,
+						Reason: strings.Join(c.Args().Tail(), " "),
+					}))
+					return err
+				}),
+			},
+			{
+				Name:      "broadcast",
+				Usage:     "Send a system message to all users in the lobby",
+				ArgsUsage: "<message>",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.BroadcastMessage(ctx, connect.NewRequest(&multiv1.BroadcastMessageRequest{
+						Text: strings.Join(c.Args().Slice(), " "),
+					}))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("Message sent to %d users\n", resp.Msg.Recipients)
+					return nil
+				}),
+			},
+			{
+				Name:  "drain",
+				Usage: "Warn the players of all connected backends and shut the backends down",
+				Flags: []cli.Flag{
+					&cli.DurationFlag{
+						Name:  "countdown",
+						Value: 30 * time.Second,
+						Usage: "Time given to the players to finish their games",
+					},
+					&cli.StringFlag{
+						Name:  "reason",
+						Usage: "Reason shown in the countdown messages",
+					},
+				},
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.DrainBackends(ctx, connect.NewRequest(&multiv1.DrainBackendsRequest{
+						CountdownSeconds: int64(c.Duration("countdown").Seconds()),
+						Reason:           c.String("reason"),
+					}))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("Shutdown requested from %d sessions\n", resp.Msg.Sessions)
+					return nil
+				}),
+			},
+			{
+				Name:      "motd",
+				Usage:     "Set the message of the day of the channel, the empty one falls back to the global message",
+				ArgsUsage: "<channel> [message]",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					_, err := client.SetChannelMOTD(ctx, connect.NewRequest(&multiv1.SetChannelMOTDRequest{
+						Channel: c.Args().First(),
+						Motd:    strings.Join(c.Args().Tail(), " "),
+					}))
+					return err
+				}),
+			},
+			{
+				Name:      "announce",
+				Usage:     "Schedule a system message sent once or repeated with the interval",
+				ArgsUsage: "<message>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "channel",
+						Usage: "Channel receiving the message, all channels when empty",
+					},
+					&cli.DurationFlag{
+						Name:  "delay",
+						Usage: "Time until the message is sent for the first time",
+					},
+					&cli.DurationFlag{
+						Name:  "interval",
+						Usage: "Time between the repeated messages, sent only once when zero",
+					},
+				},
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.CreateAnnouncement(ctx, connect.NewRequest(&multiv1.CreateAnnouncementRequest{
+						Text:            strings.Join(c.Args().Slice(), " "),
+						Channel:         c.String("channel"),
+						DelaySeconds:    int64(c.Duration("delay").Seconds()),
+						IntervalSeconds: int64(c.Duration("interval").Seconds()),
+					}))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("Announcement %d scheduled\n", resp.Msg.Announcement.AnnouncementId)
+					return nil
+				}),
+			},
+			{
+				Name:  "announcements",
+				Usage: "List scheduled announcements",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.ListAnnouncements(ctx, connect.NewRequest(&multiv1.ListAnnouncementsRequest{}))
+					if err != nil {
+						return err
+					}
+					for _, announcement := range resp.Msg.Announcements {
+						channel := announcement.Channel
+						if channel == "" {
+							channel = "*"
+						}
+						interval := "once"
+						if announcement.IntervalSeconds != 0 {
+							interval = (time.Duration(announcement.IntervalSeconds) * time.Second).String()
+						}
+						fmt.Printf("%d\tchannel=%s\tnext=%s\tevery=%s\tby=%s\t%q\n",
+							announcement.AnnouncementId, channel,
+							time.Unix(announcement.NextRunAt, 0).Format(time.RFC3339),
+							interval, announcement.CreatedBy, announcement.Text)
+					}
+					return nil
+				}),
+			},
+			{
+				Name:      "delete-announcement",
+				Usage:     "Cancel the scheduled announcement",
+				ArgsUsage: "<announcement-id>",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					announcementID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid announcement ID: %w", err)
+					}
+					_, err = client.DeleteAnnouncement(ctx, connect.NewRequest(&multiv1.DeleteAnnouncementRequest{
+						AnnouncementId: announcementID,
+					}))
+					return err
+				}),
+			},
+			{
+				Name:  "ban",
+				Usage: "Ban the user or the range of IP addresses and disconnect the matching users",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:  "user-id",
+						Usage: "ID of the user to ban",
+					},
+					&cli.StringFlag{
+						Name:  "cidr",
+						Usage: "IP address or range in CIDR notation to ban, e.g. 10.0.0.0/8",
+					},
+					&cli.StringFlag{
+						Name:  "reason",
+						Usage: "Reason shown to the banned user",
+					},
+					&cli.DurationFlag{
+						Name:  "duration",
+						Usage: "How long the ban lasts, zero means forever",
+					},
+				},
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.CreateBan(ctx, connect.NewRequest(&multiv1.CreateBanRequest{
+						UserId:          c.Int64("user-id"),
+						Cidr:            c.String("cidr"),
+						Reason:          c.String("reason"),
+						DurationSeconds: int64(c.Duration("duration").Seconds()),
+					}))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("Created ban %d, disconnected %d users\n", resp.Msg.Ban.BanId, resp.Msg.Disconnected)
+					return nil
+				}),
+			},
+			{
+				Name:  "bans",
+				Usage: "List active bans",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.ListBans(ctx, connect.NewRequest(&multiv1.ListBansRequest{}))
+					if err != nil {
+						return err
+					}
+					for _, ban := range resp.Msg.Bans {
+						target := ban.Cidr
+						if ban.UserId != 0 {
+							target = fmt.Sprintf("user=%d", ban.UserId)
+						}
+						expires := "never"
+						if ban.ExpiresAt != 0 {
+							expires = time.Unix(ban.ExpiresAt, 0).Format(time.RFC3339)
+						}
+						fmt.Printf("%d\t%s\tby=%s\texpires=%s\treason=%q\n",
+							ban.BanId, target, ban.IssuedBy, expires, ban.Reason)
+					}
+					return nil
+				}),
+			},
+			{
+				Name:      "chat-history",
+				Usage:     "List the chat messages sent to the channel, starting from the newest",
+				ArgsUsage: "<channel>",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:  "before-id",
+						Usage: "List only the messages older than the message with this ID",
+					},
+					&cli.Int64Flag{
+						Name:  "limit",
+						Value: 50,
+						Usage: "Number of the messages to list",
+					},
+				},
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.ListChatHistory(ctx, connect.NewRequest(&multiv1.ListChatHistoryRequest{
+						Channel:  c.Args().First(),
+						BeforeId: c.Int64("before-id"),
+						PageSize: c.Int64("limit"),
+					}))
+					if err != nil {
+						return err
+					}
+					for _, msg := range resp.Msg.Messages {
+						fmt.Printf("%d\t%s\t%s\t%s\n",
+							msg.MessageId,
+							time.Unix(msg.CreatedAt, 0).Format(time.RFC3339),
+							msg.Username, msg.Text)
+					}
+					if resp.Msg.NextBeforeId != 0 {
+						fmt.Printf("More messages with --before-id=%d\n", resp.Msg.NextBeforeId)
+					}
+					return nil
+				}),
+			},
+			{
+				Name:      "mute",
+				Usage:     "Prevent the user from chatting in the lobby",
+				ArgsUsage: "<user-id>",
+				Flags: []cli.Flag{
+					&cli.DurationFlag{
+						Name:  "duration",
+						Value: 10 * time.Minute,
+						Usage: "How long the user is muted",
+					},
+					&cli.StringFlag{
+						Name:  "reason",
+						Usage: "Reason shown to the muted user",
+					},
+				},
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					userID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid user ID: %w", err)
+					}
+					resp, err := client.MuteUser(ctx, connect.NewRequest(&multiv1.MuteUserRequest{
+						UserId:          userID,
+						DurationSeconds: int64(c.Duration("duration").Seconds()),
+						Reason:          c.String("reason"),
+					}))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("Muted user %d until %s\n", userID, time.Unix(resp.Msg.ExpiresAt, 0).Format(time.RFC3339))
+					return nil
+				}),
+			},
+			{
+				Name:      "unmute",
+				Usage:     "Allow the muted user to chat again",
+				ArgsUsage: "<user-id>",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					userID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid user ID: %w", err)
+					}
+					_, err = client.UnmuteUser(ctx, connect.NewRequest(&multiv1.UnmuteUserRequest{
+						UserId: userID,
+					}))
+					return err
+				}),
+			},
+			{
+				Name:  "moderation-log",
+				Usage: "List the chat moderation actions, starting from the newest",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:  "before-id",
+						Usage: "List only the entries older than the entry with this ID",
+					},
+					&cli.Int64Flag{
+						Name:  "limit",
+						Value: 50,
+						Usage: "Number of the entries to list",
+					},
+				},
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					resp, err := client.ListModerationLog(ctx, connect.NewRequest(&multiv1.ListModerationLogRequest{
+						BeforeId: c.Int64("before-id"),
+						PageSize: c.Int64("limit"),
+					}))
+					if err != nil {
+						return err
+					}
+					for _, entry := range resp.Msg.Entries {
+						fmt.Printf("%d\t%s\t%s\tuser=%d\tchannel=%q\tby=%s\t%s\n",
+							entry.EntryId,
+							time.Unix(entry.CreatedAt, 0).Format(time.RFC3339),
+							entry.Action, entry.UserId, entry.Channel, entry.Actor, entry.Reason)
+					}
+					if resp.Msg.NextBeforeId != 0 {
+						fmt.Printf("More entries with --before-id=%d\n", resp.Msg.NextBeforeId)
+					}
+					return nil
+				}),
+			},
+			{
+				Name:      "set-role",
+				Usage:     "Change the role of the user (player, moderator or admin)",
+				ArgsUsage: "<user-id> <role>",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					userID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid user ID: %w", err)
+					}
+					var role multiv1.Role
+					switch wire.Role(c.Args().Get(1)) {
+					case wire.RolePlayer:
+						role = multiv1.Role_RolePlayer
+					case wire.RoleModerator:
+						role = multiv1.Role_RoleModerator
+					case wire.RoleAdmin:
+						role = multiv1.Role_RoleAdmin
+					default:
+						return fmt.Errorf("unknown role: %q", c.Args().Get(1))
+					}
+					_, err = client.SetUserRole(ctx, connect.NewRequest(&multiv1.SetUserRoleRequest{
+						UserId: userID,
+						Role:   role,
+					}))
+					return err
+				}),
+			},
+			{
+				Name:      "unban",
+				Usage:     "Lift the ban",
+				ArgsUsage: "<ban-id>",
+				Action: withAdminClient(func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error {
+					banID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid ban ID: %w", err)
+					}
+					_, err = client.LiftBan(ctx, connect.NewRequest(&multiv1.LiftBanRequest{
+						BanId: banID,
+					}))
+					return err
+				}),
+			},
+		},
+	}
+
+	return cmd
+}
+
+func withAdminClient(fn func(ctx context.Context, c *cli.Command, client multiv1connect.AdminServiceClient) error) cli.ActionFunc {
+	return func(ctx context.Context, c *cli.Command) error {
+		client := console.NewAdminServiceClient(
+			&http.Client{Timeout: 10 * time.Second},
+			c.String("console-addr"),
+			c.String("admin-secret"),
+		)
+		return fn(ctx, c, client)
+	}
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/dimspell/gladiator/internal/app/logger"
 	"github.com/dimspell/gladiator/internal/backend"
+	"github.com/dimspell/gladiator/internal/backend/proxy/relay"
 	"github.com/urfave/cli/v3"
 )
 
@@ -45,6 +50,28 @@ func BackendCommand() *cli.Command {
 				Usage:   "Address of the relay server (only in relay proxy)",
 				Sources: cli.NewValueSourceChain(cli.EnvVar("RELAY_ADDR")),
 			},
+			&cli.StringFlag{
+				Name:    "relay-ca-file",
+				Usage:   "PEM file with the CA certificates used to verify the relay server (only in relay proxy)",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("RELAY_CA_FILE")),
+			},
+			&cli.StringFlag{
+				Name:    "relay-fingerprint",
+				Usage:   "SHA-256 fingerprint of the relay server certificate, announced by the console when empty (only in relay proxy)",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("RELAY_FINGERPRINT")),
+			},
+			&cli.DurationFlag{
+				Name:    "shutdown-countdown",
+				Value:   30 * time.Second,
+				Usage:   "Time given to the players to finish their games, before the backend shuts down",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("SHUTDOWN_COUNTDOWN")),
+			},
+			&cli.DurationFlag{
+				Name:    "shutdown-save-timeout",
+				Value:   backend.DefaultDrainPolicy.SaveTimeout,
+				Usage:   "How long the shutdown waits for the characters to be saved",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("SHUTDOWN_SAVE_TIMEOUT")),
+			},
 			&cli.StringFlag{
 				Name:    "lobby-addr",
 				Value:   defaultLobbyAddr,
@@ -77,13 +104,36 @@ func BackendCommand() *cli.Command {
 			return fmt.Errorf("incorrect run-mode - was %q; expected %q", px.Mode(), metadata.RunMode)
 		}
 
+		// Pin the certificate announced by the console, unless the relay
+		// server is verified with the configured CA or fingerprint.
+		if rx, ok := px.(*relay.ProxyRelay); ok && rx.RootCAs == nil && rx.Fingerprint == "" {
+			rx.Fingerprint = metadata.RelayServerFingerprint
+		}
+
 		bd := backend.NewBackend(backendAddr, consoleAddr, px)
 		bd.SignalServerURL = lobbyAddr
+		bd.Drain = selectDrainPolicy(c)
 
 		if err := bd.Start(); err != nil {
 			return err
 		}
 		defer bd.Shutdown()
+
+		// Drain the backend on SIGINT or SIGTERM, the listener is closed
+		// right away, so Listen returns and waits for the deferred shutdown.
+		// The second signal cuts the countdown short.
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		go func() {
+			<-ctx.Done()
+			force, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			stop()
+
+			slog.Info("Draining the backend, send the signal again to shut down immediately")
+			bd.ShutdownWith(force, bd.Drain)
+		}()
+
 		bd.Listen()
 		return nil
 	}
@@ -3,9 +3,11 @@ package action
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/console"
+	"github.com/dimspell/gladiator/internal/console/auth"
 	"github.com/urfave/cli/v3"
 )
 
@@ -38,6 +40,128 @@ func ConsoleCommand(version string) *cli.Command {
 				Usage:   "Public address to the relay server",
 				Sources: cli.NewValueSourceChain(cli.EnvVar("RELAY_PUBLIC_ADDR")),
 			},
+			&cli.StringFlag{
+				Name:    "session-secret",
+				Usage:   "Secret key used to sign the session tokens (random when empty, which drops the game rooms on restart)",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("SESSION_SECRET")),
+			},
+			&cli.StringFlag{
+				Name:    "admin-secret",
+				Usage:   "Bearer token required by the admin RPCs (disabled when empty)",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("ADMIN_SECRET")),
+			},
+			&cli.StringFlag{
+				Name:    "tls-cert-file",
+				Usage:   "PEM file with the TLS certificate of the console server (plain HTTP when empty), reloaded on SIGHUP",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("TLS_CERT_FILE")),
+			},
+			&cli.StringFlag{
+				Name:    "tls-key-file",
+				Usage:   "PEM file with the private key of the console server certificate",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("TLS_KEY_FILE")),
+			},
+			&cli.StringFlag{
+				Name:    "relay-tls-cert-file",
+				Usage:   "PEM file with the TLS certificate of the relay server (the console certificate when empty)",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("RELAY_TLS_CERT_FILE")),
+			},
+			&cli.StringFlag{
+				Name:    "relay-tls-key-file",
+				Usage:   "PEM file with the private key of the relay server certificate",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("RELAY_TLS_KEY_FILE")),
+			},
+			&cli.IntFlag{
+				Name:    "password-cost",
+				Value:   auth.DefaultPasswordCost,
+				Usage:   "Bcrypt cost of the stored passwords",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("PASSWORD_COST")),
+			},
+			&cli.StringSliceFlag{
+				Name:    "reserved-names",
+				Usage:   "User and character names that cannot be registered, next to the built-in ones",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("RESERVED_NAMES")),
+			},
+			&cli.StringFlag{
+				Name:    "name-deny-list",
+				Usage:   "File with the words, one per line, not allowed in the user and character names",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("NAME_DENY_LIST")),
+			},
+			&cli.StringFlag{
+				Name:    "motd",
+				Usage:   "Message of the day shown in the lobby",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("MOTD")),
+			},
+			&cli.IntFlag{
+				Name:    "chat-replay",
+				Value:   20,
+				Usage:   "Number of the recent chat messages shown to the users entering a channel",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("CHAT_REPLAY")),
+			},
+			&cli.IntFlag{
+				Name:    "chat-history-limit",
+				Value:   1000,
+				Usage:   "Number of the chat messages stored per channel, zero keeps all of them",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("CHAT_HISTORY_LIMIT")),
+			},
+			&cli.DurationFlag{
+				Name:    "chat-history-ttl",
+				Value:   7 * 24 * time.Hour,
+				Usage:   "How long the chat messages are stored, zero keeps them forever",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("CHAT_HISTORY_TTL")),
+			},
+			&cli.IntFlag{
+				Name:    "chat-flood-burst",
+				Value:   5,
+				Usage:   "Number of the chat messages a user can send in a row, zero disables the limit",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("CHAT_FLOOD_BURST")),
+			},
+			&cli.DurationFlag{
+				Name:    "chat-flood-interval",
+				Value:   time.Second,
+				Usage:   "Time after which the user can send one more chat message",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("CHAT_FLOOD_INTERVAL")),
+			},
+			&cli.StringFlag{
+				Name:    "chat-filter",
+				Usage:   "File with the words, one per line, forbidden in the chat",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("CHAT_FILTER")),
+			},
+			&cli.StringFlag{
+				Name:    "chat-filter-mode",
+				Value:   "mask",
+				Usage:   "What happens to the chat messages with forbidden words (mask, reject)",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("CHAT_FILTER_MODE")),
+			},
+			&cli.DurationFlag{
+				Name:    "room-restore-grace",
+				Value:   2 * time.Minute,
+				Usage:   "How long the hosts of the game rooms restored after the restart have to reconnect",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("ROOM_RESTORE_GRACE")),
+			},
+			&cli.DurationFlag{
+				Name:    "room-ready-timeout",
+				Value:   5 * time.Minute,
+				Usage:   "How long a game room can stay created before the host starts it, zero disables the limit",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("ROOM_READY_TIMEOUT")),
+			},
+			&cli.DurationFlag{
+				Name:    "room-host-timeout",
+				Value:   time.Minute,
+				Usage:   "How long a game room is kept after its host has disconnected, zero disables the limit",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("ROOM_HOST_TIMEOUT")),
+			},
+			&cli.DurationFlag{
+				Name:    "room-idle-timeout",
+				Value:   12 * time.Hour,
+				Usage:   "How long a game room is kept when nobody joins or leaves it, zero disables the limit",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("ROOM_IDLE_TIMEOUT")),
+			},
+			&cli.DurationFlag{
+				Name:    "room-kick-ban",
+				Value:   10 * time.Minute,
+				Usage:   "How long a player kicked by the host cannot join the game room again",
+				Sources: cli.NewValueSourceChain(cli.EnvVar("ROOM_KICK_BAN")),
+			},
 			&cli.StringFlag{
 				Name:    "database-type",
 				Value:   "memory",
@@ -1,6 +1,12 @@
 package ui
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/data/binding"
@@ -8,6 +14,7 @@ import (
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/model"
 )
 
@@ -46,35 +53,94 @@ func (c *Controller) AdminScreen(w fyne.Window, params *AdminScreenInputParams,
 		return container.NewVBox(
 			widget.NewLabel("Actions"),
 			widget.NewButton("Delete all game rooms", func() {
-				// if c.Console == nil {
-				// 	dialog.ShowError(fmt.Errorf("The console is not running"), w)
-				// 	return
-				// }
-				//
-				// loadingDialog := dialog.NewCustomWithoutButtons("Deleting all games", widget.NewProgressBarInfinite(), w)
-				// loadingDialog.Show()
-				//
-				// err := errors.Join(
-				// 	func() error {
-				// 		if err := c.Console.DB.Write.DeleteAllGameRoomPlayers(context.TODO()); err != nil {
-				// 			return fmt.Errorf("could not delete all game room players: %w", err)
-				// 		}
-				// 		return nil
-				// 	}(),
-				// 	func() error {
-				// 		if err := c.Console.DB.Write.DeleteAllGameRooms(context.TODO()); err != nil {
-				// 			return fmt.Errorf("could not delete all game rooms: %w", err)
-				// 		}
-				// 		return nil
-				// 	}(),
-				// )
-				//
-				// loadingDialog.Hide()
-				// if err != nil {
-				// 	dialog.ShowError(err, w)
-				// 	return
-				// }
-				dialog.ShowInformation("Not working", "Not working anymore", w)
+				loadingDialog := dialog.NewCustomWithoutButtons("Deleting all games", widget.NewProgressBarInfinite(), w)
+				loadingDialog.Show()
+
+				err := func() error {
+					ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+					defer cancel()
+
+					client := c.AdminClient()
+					rooms, err := client.ListRooms(ctx, connect.NewRequest(&multiv1.ListRoomsRequest{}))
+					if err != nil {
+						return fmt.Errorf("could not list game rooms: %w", err)
+					}
+					var errs []error
+					for _, room := range rooms.Msg.Rooms {
+						if _, err := client.DestroyRoom(ctx, connect.NewRequest(&multiv1.DestroyRoomRequest{
+							GameRoomId: room.Game.GameId,
+						})); err != nil {
+							errs = append(errs, fmt.Errorf("could not delete game room %q: %w", room.Game.GameId, err))
+						}
+					}
+					return errors.Join(errs...)
+				}()
+
+				loadingDialog.Hide()
+				if err != nil {
+					dialog.ShowError(err, w)
+					return
+				}
+				dialog.ShowInformation("Done", "All game rooms have been deleted", w)
+			}),
+			widget.NewButton("Kick a player", func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				client := c.AdminClient()
+				sessions, err := client.ListSessions(ctx, connect.NewRequest(&multiv1.ListSessionsRequest{}))
+				if err != nil {
+					dialog.ShowError(fmt.Errorf("could not list players: %w", err), w)
+					return
+				}
+
+				options := make([]string, 0, len(sessions.Msg.Sessions))
+				userIDs := make(map[string]int64, len(sessions.Msg.Sessions))
+				for _, session := range sessions.Msg.Sessions {
+					option := fmt.Sprintf("%s (#%d)", session.Username, session.UserId)
+					options = append(options, option)
+					userIDs[option] = session.UserId
+				}
+
+				player := widget.NewSelect(options, nil)
+				reason := widget.NewEntry()
+				dialog.ShowForm("Kick a player", "Kick", "Cancel", []*widget.FormItem{
+					widget.NewFormItem("Player", player),
+					widget.NewFormItem("Reason", reason),
+				}, func(confirmed bool) {
+					userID, ok := userIDs[player.Selected]
+					if !confirmed || !ok {
+						return
+					}
+					ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+					defer cancel()
+					if _, err := client.KickUser(ctx, connect.NewRequest(&multiv1.KickUserRequest{
+						UserId: userID,
+						Reason: reason.Text,
+					})); err != nil {
+						dialog.ShowError(err, w)
+					}
+				}, w)
+			}),
+			widget.NewButton("Broadcast a message", func() {
+				text := widget.NewEntry()
+				dialog.ShowForm("Broadcast a message", "Send", "Cancel", []*widget.FormItem{
+					widget.NewFormItem("Message", text),
+				}, func(confirmed bool) {
+					if !confirmed || text.Text == "" {
+						return
+					}
+					ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+					defer cancel()
+					resp, err := c.AdminClient().BroadcastMessage(ctx, connect.NewRequest(&multiv1.BroadcastMessageRequest{
+						Text: text.Text,
+					}))
+					if err != nil {
+						dialog.ShowError(err, w)
+						return
+					}
+					dialog.ShowInformation("Done", fmt.Sprintf("Message sent to %d players", resp.Msg.Recipients), w)
+				}, w)
 			}),
 		)
 	}
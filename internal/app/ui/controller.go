@@ -2,11 +2,15 @@ package ui
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/data/binding"
+	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend"
 	"github.com/dimspell/gladiator/internal/console"
@@ -94,7 +98,10 @@ func (c *Controller) StartConsole(databaseType, databasePath, consoleAddr string
 		}
 	}()
 
-	c.Console = console.NewConsole(db, console.WithConsoleAddr(consoleAddr, "http://"+consoleAddr))
+	c.Console = console.NewConsole(db,
+		console.WithConsoleAddr(consoleAddr, "http://"+consoleAddr),
+		console.WithAdminSecret(rand.Text()),
+	)
 	c.Console.Config.RunMode = runMode
 
 	start, stop := c.Console.Handlers()
@@ -191,6 +198,16 @@ func (c *Controller) StopBackend() {
 	c.backendProbe.Signal(probe.StatusNotRunning)
 }
 
+// AdminClient returns a client of the admin API of the console started by the
+// controller.
+func (c *Controller) AdminClient() multiv1connect.AdminServiceClient {
+	return console.NewAdminServiceClient(
+		&http.Client{Timeout: 5 * time.Second},
+		c.Console.Config.ConsolePublicAddr,
+		string(c.Console.Config.AdminSecret),
+	)
+}
+
 func (c *Controller) ConsoleRunning() bool {
 	return c.Console != nil
 }
@@ -82,7 +82,10 @@ func (c *Controller) playView(w fyne.Window, consoleAddr string, metadata *model
 		var proxyCreator backend.Proxy
 		switch metadata.RunMode {
 		case model.RunModeRelay:
-			proxyCreator = &relay.ProxyRelay{RelayServerAddr: metadata.RelayServerAddr}
+			proxyCreator = &relay.ProxyRelay{
+				RelayServerAddr: metadata.RelayServerAddr,
+				Fingerprint:     metadata.RelayServerFingerprint,
+			}
 		default:
 			proxyCreator = &direct.ProxyLAN{MyIPAddress: myIPEntry.Text}
 		}
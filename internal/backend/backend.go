@@ -14,8 +14,6 @@ import (
 
 	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
-	"github.com/dimspell/gladiator/internal/backend/bsession"
-	"github.com/dimspell/gladiator/internal/backend/packet"
 	"github.com/dimspell/gladiator/internal/model"
 )
 
@@ -29,23 +27,37 @@ type Backend struct {
 
 	CreateProxy Proxy
 
+	// Drain is used to warn the players, when the backend shuts down.
+	Drain DrainPolicy
+
+	// Reconnect is used to connect to the lobby again, when the connection
+	// to the console has been lost.
+	Reconnect ReconnectPolicy
+
+	shutdown sync.Once
+	saves    inflight
+
 	characterClient multiv1connect.CharacterServiceClient
 	gameClient      multiv1connect.GameServiceClient
 	userClient      multiv1connect.UserServiceClient
 	rankingClient   multiv1connect.RankingServiceClient
+	channelClient   multiv1connect.ChannelServiceClient
 }
 
 func NewBackend(backendAddr, consolePublicAddr string, createProxy Proxy) *Backend {
-	characterClient, gameClient, userClient, rankingClient := createServiceClients(consolePublicAddr)
+	characterClient, gameClient, userClient, rankingClient, channelClient := createServiceClients(consolePublicAddr)
 
 	return &Backend{
 		Addr:        backendAddr,
 		CreateProxy: createProxy,
+		Drain:       DefaultDrainPolicy,
+		Reconnect:   DefaultReconnectPolicy,
 
 		characterClient: characterClient,
 		gameClient:      gameClient,
 		userClient:      userClient,
 		rankingClient:   rankingClient,
+		channelClient:   channelClient,
 	}
 }
 
@@ -54,6 +66,7 @@ func createServiceClients(consoleAddr string) (
 	multiv1connect.GameServiceClient,
 	multiv1connect.UserServiceClient,
 	multiv1connect.RankingServiceClient,
+	multiv1connect.ChannelServiceClient,
 ) {
 	httpClient := &http.Client{
 		Timeout: 5 * time.Second,
@@ -74,8 +87,9 @@ func createServiceClients(consoleAddr string) (
 	gameClient := multiv1connect.NewGameServiceClient(httpClient, consoleUri)
 	userClient := multiv1connect.NewUserServiceClient(httpClient, consoleUri)
 	rankingClient := multiv1connect.NewRankingServiceClient(httpClient, consoleUri)
+	channelClient := multiv1connect.NewChannelServiceClient(httpClient, consoleUri)
 
-	return characterClient, gameClient, userClient, rankingClient
+	return characterClient, gameClient, userClient, rankingClient, channelClient
 }
 
 func (b *Backend) Start() error {
@@ -94,48 +108,18 @@ func (b *Backend) Start() error {
 	return nil
 }
 
-func (b *Backend) Shutdown() {
-	slog.Info("Shutting down the backend...")
-
-	// Close all open connections
-	b.ConnectedSessions.Range(func(k, v any) bool {
-		session := v.(*bsession.Session)
-
-		// TODO: Send a system message "(system) The server is going to close in less than 30 seconds"
-		_ = session.SendToGame(
-			packet.ReceiveMessage,
-			NewGlobalMessage("system-info", "The server is going to shut down..."))
-
-		// TODO: Send a packet to trigger stats saving
-		// TODO: Send a system message "(system): Your stats were saving, your game client might close in the next 10 seconds"
-
-		// TODO: Send a packet to close the connection (malformed 255-21?)
-		if err := session.Conn.Close(); err != nil {
-			slog.Error("Could not close session", logging.Error(err), "session", session.ID)
-		}
-
-		return true
-	})
-
-	if b.listener != nil {
-		if err := b.listener.Close(); err != nil {
-			slog.Warn("Could not close listener", logging.Error(err))
-		}
-		b.listener = nil
-	}
-
-	slog.Info("The backend has successfully shut down")
-}
-
 func (b *Backend) Listen() {
 	slog.Info("Backend is listening for new connections...", "addr", b.Addr)
 
+	// The shutdown closes and clears the listener, so keep hold of it.
+	listener := b.listener
+	if listener == nil {
+		return
+	}
+
 	for {
-		if b.listener == nil {
-			return
-		}
 		// Listen for an incoming connection.
-		conn, err := b.listener.Accept()
+		conn, err := listener.Accept()
 		if err != nil {
 			if errors.Is(err, net.ErrClosed) {
 				return
@@ -193,7 +177,7 @@ func (b *Backend) handleClient(conn net.Conn) error {
 
 func GetMetadata(ctx context.Context, consoleAddr string) (*model.WellKnown, error) {
 	httpClient := &http.Client{Timeout: 3 * time.Second}
-	
+
 	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/.well-known/console.json", consoleAddr), nil)
 	if err != nil {
 		return nil, err
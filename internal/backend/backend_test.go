@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +14,8 @@ import (
 	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
 	"github.com/dimspell/gladiator/internal/backend/proxy/direct"
 	"github.com/dimspell/gladiator/internal/console"
+	"github.com/dimspell/gladiator/internal/console/auth"
+	"github.com/dimspell/gladiator/internal/console/database"
 )
 
 type mockConn struct {
@@ -99,6 +102,8 @@ type mockGameClient struct {
 
 	GetGameResponse    *connect.Response[v1.GetGameResponse]
 	JoinGameResponse   *connect.Response[v1.JoinGameResponse]
+	JoinGameErr        error
+	JoinGameRequest    *v1.JoinGameRequest
 	CreateGameResponse *connect.Response[v1.CreateGameResponse]
 	ListGamesResponse  *connect.Response[v1.ListGamesResponse]
 }
@@ -107,8 +112,9 @@ func (m *mockGameClient) GetGame(context.Context, *connect.Request[v1.GetGameReq
 	return m.GetGameResponse, nil
 }
 
-func (m *mockGameClient) JoinGame(context.Context, *connect.Request[v1.JoinGameRequest]) (*connect.Response[v1.JoinGameResponse], error) {
-	return m.JoinGameResponse, nil
+func (m *mockGameClient) JoinGame(_ context.Context, req *connect.Request[v1.JoinGameRequest]) (*connect.Response[v1.JoinGameResponse], error) {
+	m.JoinGameRequest = req.Msg
+	return m.JoinGameResponse, m.JoinGameErr
 }
 
 func (m *mockGameClient) CreateGame(context.Context, *connect.Request[v1.CreateGameRequest]) (*connect.Response[v1.CreateGameResponse], error) {
@@ -134,11 +140,32 @@ func (m *mockCharacterClient) ListCharacters(context.Context, *connect.Request[v
 	return m.ListCharactersResponse, nil
 }
 
+type mockChannelClient struct {
+	multiv1connect.UnimplementedChannelServiceHandler
+
+	ListChannelsResponse *connect.Response[v1.ListChannelsResponse]
+}
+
+func (m *mockChannelClient) ListChannels(context.Context, *connect.Request[v1.ListChannelsRequest]) (*connect.Response[v1.ListChannelsResponse], error) {
+	return m.ListChannelsResponse, nil
+}
+
 func helperNewBackend(tb testing.TB) (bd *Backend, px *direct.ProxyLAN, cs *console.Console) {
 	tb.Helper()
 
+	db, err := database.NewMemory()
+	if err != nil {
+		tb.Fatalf("failed to create database: %v", err)
+	}
+
+	mp := console.NewMultiplayer()
+	mp.Channels = console.NewChannelList(db)
+
 	cs = &console.Console{
-		Multiplayer: console.NewMultiplayer(),
+		Multiplayer: mp,
+		Sessions:    auth.NewSessionSigner([]byte("secret"), time.Hour, time.Now),
+		DB:          db,
+		Bans:        console.NewBanList(db, time.Now),
 	}
 	ts := httptest.NewServer(http.HandlerFunc(cs.HandleWebSocket))
 
@@ -154,7 +181,23 @@ func helperNewBackend(tb testing.TB) (bd *Backend, px *direct.ProxyLAN, cs *cons
 
 	tb.Cleanup(func() {
 		ts.Close()
+		_ = db.Close()
 	})
 
 	return bd, px, cs
 }
+
+func helperIssueToken(tb testing.TB, cs *console.Console, userID int64) string {
+	tb.Helper()
+
+	// The lobby accepts only the users existing in the database.
+	if _, err := cs.DB.Writer.Exec("INSERT OR IGNORE INTO users (id, username, password) VALUES (?, ?, '')", userID, fmt.Sprint("user", userID)); err != nil {
+		tb.Fatalf("could not create the user: %v", err)
+	}
+
+	token, err := cs.Sessions.Issue(userID)
+	if err != nil {
+		tb.Fatalf("could not issue session token: %v", err)
+	}
+	return token
+}
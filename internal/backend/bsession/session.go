@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/coder/websocket"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger"
@@ -30,6 +31,14 @@ type Session struct {
 	CharacterID int64
 	ClassType   model.ClassType
 
+	// Token is the bearer token issued by the console on sign-in. It is
+	// attached to every request made on behalf of the user.
+	Token string
+
+	// RelayKey is issued by the console on sign-in and used to sign the
+	// packets sent to the relay server.
+	RelayKey []byte
+
 	// Conn stores the TCP connection between the backend and the game client.
 	Conn net.Conn
 
@@ -37,6 +46,11 @@ type Session struct {
 	observerDone          context.CancelFunc
 	wsConn                *websocket.Conn
 
+	// lobbyURL and lobbyCtx are kept to connect to the lobby again, when the
+	// connection is lost. The lobbyCtx is cancelled, when the session stops.
+	lobbyURL string
+	lobbyCtx context.Context
+
 	State *SessionState
 	Proxy proxy.ProxyClient
 }
@@ -71,6 +85,22 @@ func (s *Session) UpdateCharacter(character *multiv1.Character) {
 
 func (s *Session) GetUserID() int64 { return s.UserID }
 
+// Authorization returns the value of the Authorization header used in the
+// requests to the console.
+func (s *Session) Authorization() string {
+	s.RLock()
+	defer s.RUnlock()
+	return "Bearer " + s.Token
+}
+
+// NewRequest wraps the message into a request to the console, authorized with
+// the bearer token of the session.
+func NewRequest[T any](s *Session, msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", s.Authorization())
+	return req
+}
+
 func (s *Session) SendToGame(packetType packet.Code, payload []byte) error {
 	return sendPacket(s.Conn, packetType, payload)
 }
@@ -140,7 +170,7 @@ func (s *Session) StopObserver() {
 	}
 }
 
-func (s *Session) ConnectOverWebsocket(ctx context.Context, user *multiv1.User, wsURL string) error {
+func (s *Session) ConnectOverWebsocket(ctx context.Context, user *multiv1.User, token string, wsURL string) error {
 	s.Lock()
 	defer s.Unlock()
 
@@ -148,24 +178,83 @@ func (s *Session) ConnectOverWebsocket(ctx context.Context, user *multiv1.User,
 		UserID:   user.UserId,
 		Username: user.Username,
 		Version:  wire.ProtoVersion,
-	})
+	}, token)
 	if err != nil {
 		return err
 	}
 
-	ctx, s.observerDone = context.WithCancel(ctx)
+	s.Token = token
+	s.lobbyURL = wsURL
+
+	s.lobbyCtx, s.observerDone = context.WithCancel(ctx)
 	s.wsConn = ws
 
-	go func(ctx context.Context, ws *websocket.Conn) {
-		<-ctx.Done()
+	go closeWhenDone(s.lobbyCtx, ws)
+	return nil
+}
+
+// ReconnectLobby connects to the lobby again, after the connection has been
+// lost, e.g. when the console has been restarted. The user is signed in with
+// the token issued on sign-in and joins the lobby with the selected character.
+func (s *Session) ReconnectLobby() error {
+	s.RLock()
+	ctx, lobbyURL, token := s.lobbyCtx, s.lobbyURL, s.Token
+	user := wire.User{UserID: s.UserID, Username: s.Username, Version: wire.ProtoVersion}
+	s.RUnlock()
+
+	if ctx == nil {
+		return fmt.Errorf("session has never connected to the lobby")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	ws, err := wire.Connect(ctx, lobbyURL, user, token)
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	lost := s.wsConn
+	s.wsConn = ws
+	s.Unlock()
+	if lost != nil {
+		lost.CloseNow()
+	}
+
+	go closeWhenDone(ctx, ws)
+	if err := s.JoinLobby(ctx); err != nil {
 		ws.CloseNow()
-		return
-	}(ctx, ws)
+		return err
+	}
 	return nil
 }
 
+// LobbyDone is closed, when the session stops and must not connect to the
+// lobby anymore.
+func (s *Session) LobbyDone() <-chan struct{} {
+	s.RLock()
+	defer s.RUnlock()
+	if s.lobbyCtx == nil {
+		return nil
+	}
+	return s.lobbyCtx.Done()
+}
+
+func closeWhenDone(ctx context.Context, ws *websocket.Conn) {
+	<-ctx.Done()
+	ws.CloseNow()
+}
+
+// lobbyConn returns the current connection to the lobby, which is replaced
+// after reconnecting.
+func (s *Session) lobbyConn() *websocket.Conn {
+	s.RLock()
+	defer s.RUnlock()
+	return s.wsConn
+}
+
 func (s *Session) ConsumeWebSocket(ctx context.Context) ([]byte, error) {
-	_, p, err := s.wsConn.Read(ctx)
+	_, p, err := s.lobbyConn().Read(ctx)
 	return p, err
 }
 
@@ -173,7 +262,7 @@ func (s *Session) SendEvent(ctx context.Context, eventType wire.EventType, conte
 	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
 	defer cancel()
 
-	return wire.Write(ctx, s.wsConn, wire.Compose(
+	return wire.Write(ctx, s.lobbyConn(), wire.Compose(
 		eventType,
 		wire.Message{
 			From:    strconv.Itoa(int(s.GetUserID())),
@@ -187,7 +276,7 @@ func (s *Session) SendEventTo(ctx context.Context, eventType wire.EventType, con
 	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
 	defer cancel()
 
-	return wire.Write(ctx, s.wsConn, wire.Compose(
+	return wire.Write(ctx, s.lobbyConn(), wire.Compose(
 		eventType,
 		wire.Message{
 			From:    strconv.Itoa(int(s.GetUserID())),
@@ -212,7 +301,7 @@ func (s *Session) JoinLobby(ctx context.Context) error {
 	}
 
 	// Expect to receive the joined message.
-	_, response, err := s.wsConn.Read(ctx)
+	_, response, err := s.lobbyConn().Read(ctx)
 	if err != nil {
 		return fmt.Errorf("failed receive a join lobby message: %w", err)
 	}
@@ -229,6 +318,16 @@ func (s *Session) SendChatMessage(ctx context.Context, text string) error {
 	})
 }
 
+// SendPrivateMessage sends a message visible only to the user with the given
+// name.
+func (s *Session) SendPrivateMessage(ctx context.Context, username string, text string) error {
+	return s.SendEvent(ctx, wire.PrivateMessage, wire.Whisper{
+		From: s.Username,
+		To:   username,
+		Text: text,
+	})
+}
+
 func (s *Session) SendSetRoomReady(ctx context.Context, gameRoomId string) error {
 	return s.SendEvent(ctx, wire.SetRoomReady, gameRoomId)
 }
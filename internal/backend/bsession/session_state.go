@@ -10,8 +10,39 @@ import (
 type SessionState struct {
 	sync.RWMutex
 
-	// lobbyUsers contains list of players who are connected to lobby server.
+	// lobbyUsers contains list of players who are connected to lobby server
+	// and present in the same channel.
 	lobbyUsers []wire.Player
+
+	// channel is the name of the lobby channel the user is present in.
+	channel string
+
+	// motd is the message of the day of the channel.
+	motd string
+}
+
+func (s *SessionState) SetChannel(channel string) {
+	s.Lock()
+	s.channel = channel
+	s.Unlock()
+}
+
+func (s *SessionState) GetChannel() string {
+	s.RLock()
+	defer s.RUnlock()
+	return s.channel
+}
+
+func (s *SessionState) SetMOTD(motd string) {
+	s.Lock()
+	s.motd = motd
+	s.Unlock()
+}
+
+func (s *SessionState) GetMOTD() string {
+	s.RLock()
+	defer s.RUnlock()
+	return s.motd
 }
 
 func (s *SessionState) UpdateLobbyUsers(users []wire.Player) {
@@ -7,7 +7,6 @@ import (
 	"log/slog"
 	"net"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
 	"github.com/dimspell/gladiator/internal/backend/packet"
@@ -20,7 +19,7 @@ func (b *Backend) HandleListGames(ctx context.Context, session *bsession.Session
 		return fmt.Errorf("packet-09: user is not logged in")
 	}
 
-	resp, err := b.gameClient.ListGames(ctx, connect.NewRequest(&multiv1.ListGamesRequest{}))
+	resp, err := b.gameClient.ListGames(ctx, bsession.NewRequest(session, &multiv1.ListGamesRequest{}))
 	if err != nil {
 		slog.Error("packet-09: could not list game rooms")
 		return nil
@@ -35,9 +34,11 @@ func (b *Backend) HandleListGames(ctx context.Context, session *bsession.Session
 			slog.Debug("packet-09: could not parse room ip address", "ip", room.HostIpAddress)
 		}
 
+		// The password of the protected rooms is never listed. The game
+		// compares the password typed by the player with the listed one, so
+		// any placeholder would block the join.
 		lobby := model.LobbyRoom{
 			Name:          room.Name,
-			Password:      room.Password,
 			HostIPAddress: session.Proxy.GetHostIP(roomIP).To4(),
 		}
 
@@ -47,7 +47,6 @@ func TestBackend_HandleListGames(t *testing.T) {
 					{
 						GameId:        "gameId",
 						Name:          "retreat",
-						Password:      "",
 						HostIpAddress: "127.0.21.37",
 						MapId:         v1.GameMap_UnderworldRetreat,
 					},
@@ -75,14 +74,13 @@ func TestBackend_HandleListGames(t *testing.T) {
 					{
 						GameId:        "gameId",
 						Name:          "RoomName",
-						Password:      "secret",
+						HasPassword:   true,
 						HostIpAddress: "127.0.21.37",
 						MapId:         v1.GameMap_UnderworldRetreat,
 					},
 					{
 						GameId:        "gameId",
 						Name:          "Other",
-						Password:      "",
 						HostIpAddress: "127.0.13.37",
 						MapId:         v1.GameMap_AbandonedRealm,
 					},
@@ -93,14 +91,14 @@ func TestBackend_HandleListGames(t *testing.T) {
 		session.Proxy = b.CreateProxy.Create(session)
 
 		assert.NoError(t, b.HandleListGames(context.Background(), session, ListGamesRequest{}))
-		assert.Len(t, conn.Written, 39)
-		assert.Equal(t, []byte{255, 9, 39, 0}, conn.Written[0:4])    // Header
+		assert.Len(t, conn.Written, 33)
+		assert.Equal(t, []byte{255, 9, 33, 0}, conn.Written[0:4])    // Header
 		assert.Equal(t, []byte{2, 0, 0, 0}, conn.Written[4:8])       // Number of games
 		assert.Equal(t, []byte{127, 0, 21, 37}, conn.Written[8:12])  // Host IP Address
 		assert.Equal(t, []byte("RoomName\x00"), conn.Written[12:21]) // Room name
-		assert.Equal(t, []byte("secret\x00"), conn.Written[21:28])   // Password
-		assert.Equal(t, []byte{127, 0, 13, 37}, conn.Written[28:32]) // Host IP Address
-		assert.Equal(t, []byte("Other\x00"), conn.Written[32:38])    // Room name
-		assert.Equal(t, []byte("\x00"), conn.Written[38:39])         // Password
+		assert.Equal(t, []byte("\x00"), conn.Written[21:22])         // Password is never listed
+		assert.Equal(t, []byte{127, 0, 13, 37}, conn.Written[22:26]) // Host IP Address
+		assert.Equal(t, []byte("Other\x00"), conn.Written[26:32])    // Room name
+		assert.Equal(t, []byte("\x00"), conn.Written[32:33])         // Password
 	})
 }
@@ -2,17 +2,28 @@ package backend
 
 import (
 	"context"
+	"fmt"
 
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
 	"github.com/dimspell/gladiator/internal/backend/packet"
-	"github.com/dimspell/gladiator/internal/console/database"
 )
 
 // HandleListChannels handles 0xbff (255-11) command
 func (b *Backend) HandleListChannels(ctx context.Context, session *bsession.Session, req ListChannelsRequest) error {
+	if session.UserID == 0 {
+		return fmt.Errorf("packet-11: user is not logged in")
+	}
+
+	respChannels, err := b.channelClient.ListChannels(ctx,
+		bsession.NewRequest(session, &multiv1.ListChannelsRequest{}))
+	if err != nil {
+		return fmt.Errorf("packet-11: could not list channels: %w", err)
+	}
+
 	var response []byte
-	for _, channel := range database.Channels {
-		response = append(response, channel...)
+	for _, channel := range respChannels.Msg.GetChannels() {
+		response = append(response, channel.Name...)
 		response = append(response, 0)
 	}
 	return session.SendToGame(packet.ListChannels, response)
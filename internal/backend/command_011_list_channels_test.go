@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	"connectrpc.com/connect"
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
 	"github.com/stretchr/testify/assert"
 )
@@ -23,12 +25,21 @@ func TestListChannelsRequest(t *testing.T) {
 }
 
 func TestBackend_HandleListChannels(t *testing.T) {
-	b := &Backend{}
+	b := &Backend{
+		channelClient: &mockChannelClient{
+			ListChannelsResponse: connect.NewResponse(&v1.ListChannelsResponse{
+				Channels: []*v1.Channel{
+					{Name: "DISPEL"},
+					{Name: "PVP", MinLevel: 10},
+				},
+			}),
+		},
+	}
 	conn := &mockConn{}
 	session := &bsession.Session{ID: "TEST", Conn: conn, UserID: 2137, Username: "JP"}
 
 	assert.NoError(t, b.HandleListChannels(context.Background(), session, ListChannelsRequest{}))
-	assert.Equal(t, []byte{255, 11, 11, 0}, conn.Written[0:4]) // Header
-	assert.Equal(t, []byte("DISPEL\x00"), conn.Written[4:11])  // Channel name
-	assert.Len(t, conn.Written, 11)
+	assert.Equal(t, []byte{255, 11, 15, 0}, conn.Written[0:4])       // Header
+	assert.Equal(t, []byte("DISPEL\x00PVP\x00"), conn.Written[4:15]) // Channel names
+	assert.Len(t, conn.Written, 15)
 }
@@ -8,39 +8,51 @@ import (
 	"github.com/dimspell/gladiator/internal/backend/bsession"
 	"github.com/dimspell/gladiator/internal/backend/packet"
 	"github.com/dimspell/gladiator/internal/model"
+	"github.com/dimspell/gladiator/internal/wire"
 )
 
+// HandleSelectChannel handles 0xcff (255-12) command.
+//
+// Selecting the channel the user is already present in lists the players in
+// the lobby, after showing the message of the day of the channel. Any other
+// channel is requested from the lobby server, and the list is sent once the
+// server has let the user in (see LobbyEventHandler).
 func (b *Backend) HandleSelectChannel(ctx context.Context, session *bsession.Session, req SelectChannelRequest) error {
-	serverName, channelName, err := req.Parse()
+	channelName, serverName, err := req.Parse()
 	slog.Info("Selected channel", "serverName", serverName, "channelName", channelName, "error", err)
+	if err != nil {
+		return nil
+	}
+
+	if channelName != session.State.GetChannel() {
+		return session.SendEvent(ctx, wire.JoinChannel, wire.ChannelJoin{Channel: channelName})
+	}
 
 	if err := session.SendToGame(packet.ReceiveMessage, SetChannelName(channelName)); err != nil {
 		return err
 	}
-
-	if serverName == "DISPEL" && channelName == "DISPEL" {
-		for idx, user := range session.State.GetLobbyUsers() {
-			session.SendToGame(packet.ReceiveMessage, AppendCharacterToLobby(user.Username, model.ClassType(user.ClassType), uint32(idx)))
+	if motd := session.State.GetMOTD(); motd != "" {
+		if err := session.SendToGame(packet.ReceiveMessage, NewLobbyMessage("system-info", motd)); err != nil {
+			return err
 		}
-		// session.Send(ReceiveMessage, NewGlobalMessage("admin", "hello"))
 	}
-
-	// b.Proxy.Close()
-
+	for idx, user := range session.State.GetLobbyUsers() {
+		session.SendToGame(packet.ReceiveMessage, AppendCharacterToLobby(user.Username, model.ClassType(user.ClassType), uint32(idx)))
+	}
 	return nil
 }
 
 type SelectChannelRequest []byte
 
-func (r SelectChannelRequest) Parse() (serverName string, channelName string, err error) {
+func (r SelectChannelRequest) Parse() (channelName string, serverName string, err error) {
 	rd := packet.NewReader(r)
-	serverName, err = rd.ReadString()
-	if err != nil {
-		return "", "", fmt.Errorf("error parsing server name: %w", err)
-	}
 	channelName, err = rd.ReadString()
 	if err != nil {
 		return "", "", fmt.Errorf("error parsing channel name: %w", err)
 	}
-	return serverName, channelName, err
+	serverName, err = rd.ReadString()
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing server name: %w", err)
+	}
+	return channelName, serverName, err
 }
@@ -1,8 +1,12 @@
 package backend
 
 import (
+	"context"
 	"testing"
 
+	"github.com/dimspell/gladiator/internal/backend/bsession"
+	"github.com/dimspell/gladiator/internal/backend/packet"
+	"github.com/dimspell/gladiator/internal/wire"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,3 +27,31 @@ func TestSelectChannelRequest(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "channel", channelName)
 }
+
+func TestBackend_HandleSelectChannel(t *testing.T) {
+	b := &Backend{}
+	conn := &mockConn{}
+	session := &bsession.Session{ID: "TEST", Conn: conn, UserID: 2137, Username: "JP", State: &bsession.SessionState{}}
+	session.State.SetChannel("DISPEL")
+	session.State.UpdateLobbyUsers([]wire.Player{{UserID: 2137, Username: "JP"}})
+
+	assert.NoError(t, b.HandleSelectChannel(context.Background(), session, SelectChannelRequest("DISPEL\x00DISPEL\x00")))
+
+	expected := packet.EncodePacket(packet.ReceiveMessage, SetChannelName("DISPEL"))
+	expected = append(expected, packet.EncodePacket(packet.ReceiveMessage, AppendCharacterToLobby("JP", 0, 0))...)
+	assert.Equal(t, expected, conn.Written)
+}
+
+func TestBackend_HandleSelectChannel_MOTD(t *testing.T) {
+	b := &Backend{}
+	conn := &mockConn{}
+	session := &bsession.Session{ID: "TEST", Conn: conn, UserID: 2137, Username: "JP", State: &bsession.SessionState{}}
+	session.State.SetChannel("DISPEL")
+	session.State.SetMOTD("Welcome to DISPEL")
+
+	assert.NoError(t, b.HandleSelectChannel(context.Background(), session, SelectChannelRequest("DISPEL\x00DISPEL\x00")))
+
+	expected := packet.EncodePacket(packet.ReceiveMessage, SetChannelName("DISPEL"))
+	expected = append(expected, packet.EncodePacket(packet.ReceiveMessage, NewLobbyMessage("system-info", "Welcome to DISPEL"))...)
+	assert.Equal(t, expected, conn.Written)
+}
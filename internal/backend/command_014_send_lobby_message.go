@@ -5,8 +5,10 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/dimspell/gladiator/internal/backend/bsession"
+	"github.com/dimspell/gladiator/internal/backend/packet"
 )
 
 func (b *Backend) HandleSendLobbyMessage(ctx context.Context, session *bsession.Session, req SendLobbyMessageRequest) error {
@@ -18,6 +20,16 @@ func (b *Backend) HandleSendLobbyMessage(ctx context.Context, session *bsession.
 		return nil
 	}
 
+	if username, text, ok := parseWhisper(message); ok {
+		if username == "" || text == "" {
+			return session.SendToGame(packet.ReceiveMessage, NewLobbyMessage("system-info", "Usage: /w <name> <text>"))
+		}
+		if err := session.SendPrivateMessage(ctx, username, text); err != nil {
+			slog.Warn("Could not send WS message", "error", fmt.Errorf("packet-14: could not send private message: %w", err))
+		}
+		return nil
+	}
+
 	if err := session.SendChatMessage(ctx, message); err != nil {
 		slog.Warn("Could not send WS message", "error", fmt.Errorf("packet-14: could not send chat message: %w", err))
 	}
@@ -26,6 +38,17 @@ func (b *Backend) HandleSendLobbyMessage(ctx context.Context, session *bsession.
 	return nil // session.Send(ReceiveMessage, resp)
 }
 
+// parseWhisper splits the "/w <name> <text>" command into the name of the
+// recipient and the text of the private message.
+func parseWhisper(message string) (username string, text string, ok bool) {
+	rest, found := strings.CutPrefix(message, "/w ")
+	if !found {
+		return "", "", message == "/w"
+	}
+	username, text, _ = strings.Cut(strings.TrimLeft(rest, " "), " ")
+	return username, strings.TrimSpace(text), true
+}
+
 type SendLobbyMessageRequest []byte
 
 func (c SendLobbyMessageRequest) Parse() (message string, err error) {
@@ -71,3 +71,26 @@ func TestSendLobbyMessageRequest_Parse(t *testing.T) {
 		assert.Empty(t, message)
 	})
 }
+
+func TestParseWhisper(t *testing.T) {
+	for _, tc := range []struct {
+		message  string
+		username string
+		text     string
+		ok       bool
+	}{
+		{"/w archer hello there", "archer", "hello there", true},
+		{"/w  archer   hi ", "archer", "hi", true},
+		{"/w archer", "archer", "", true},
+		{"/w", "", "", true},
+		{"/wave", "", "", false},
+		{"hello /w archer", "", "", false},
+	} {
+		t.Run(tc.message, func(t *testing.T) {
+			username, text, ok := parseWhisper(tc.message)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.username, username)
+			assert.Equal(t, tc.text, text)
+		})
+	}
+}
@@ -65,6 +65,15 @@ func NewLobbyMessage(user, text string) []byte {
 	return buf
 }
 
+// NewPrivateMessage creates a lobby message, which marks the text as a private
+// message received from the user, or sent to the user when outgoing is true.
+func NewPrivateMessage(user, text string, outgoing bool) []byte {
+	if outgoing {
+		return NewLobbyMessage("to "+user, text)
+	}
+	return NewLobbyMessage("from "+user, text)
+}
+
 func SetChannelName(channelName string) []byte {
 	buf := make([]byte, 4+4+4+1+len(channelName)+1)
 
@@ -6,7 +6,6 @@ import (
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"log/slog"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
 	"github.com/dimspell/gladiator/internal/backend/packet"
@@ -33,7 +32,7 @@ func (b *Backend) HandleCreateGame(ctx context.Context, session *bsession.Sessio
 			return session.SendToGame(packet.CreateGame, []byte{2, 0, 0, 0})
 		}
 
-		respGame, err := b.gameClient.CreateGame(ctx, connect.NewRequest(&multiv1.CreateGameRequest{
+		respGame, err := b.gameClient.CreateGame(ctx, bsession.NewRequest(session, &multiv1.CreateGameRequest{
 			GameName:      data.RoomName,
 			Password:      data.Password,
 			MapId:         multiv1.GameMap(data.MapID),
@@ -49,7 +48,7 @@ func (b *Backend) HandleCreateGame(ctx context.Context, session *bsession.Sessio
 		return session.SendToGame(packet.CreateGame, []byte{model.GameStateCreating, 0, 0, 0})
 
 	case uint32(model.GameStateCreating):
-		respGame, err := b.gameClient.GetGame(ctx, connect.NewRequest(&multiv1.GetGameRequest{
+		respGame, err := b.gameClient.GetGame(ctx, bsession.NewRequest(session, &multiv1.GetGameRequest{
 			GameRoomId: data.RoomName,
 		}))
 		if err != nil {
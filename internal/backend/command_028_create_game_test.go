@@ -33,13 +33,12 @@ func TestCreateGameRequest(t *testing.T) {
 }
 
 func TestBackend_HandleCreateGame(t *testing.T) {
-	b, _, _ := helperNewBackend(t)
+	b, _, cs := helperNewBackend(t)
 	b.gameClient = &mockGameClient{
 		CreateGameResponse: connect.NewResponse(&v1.CreateGameResponse{
 			Game: &v1.Game{
 				GameId:        "room",
 				Name:          "room",
-				Password:      "",
 				HostIpAddress: "127.0.0.1",
 				MapId:         v1.GameMap_FrozenLabyrinth,
 				HostUserId:    2137,
@@ -49,7 +48,6 @@ func TestBackend_HandleCreateGame(t *testing.T) {
 			Game: &v1.Game{
 				GameId:        "room",
 				Name:          "room",
-				Password:      "",
 				HostIpAddress: "127.0.0.1",
 				MapId:         v1.GameMap_FrozenLabyrinth,
 				HostUserId:    2137,
@@ -72,7 +70,7 @@ func TestBackend_HandleCreateGame(t *testing.T) {
 	session.ID = "TEST"
 
 	ctx := context.Background()
-	if err := b.ConnectToLobby(ctx, &v1.User{UserId: session.UserID, Username: session.Username}, session); err != nil {
+	if err := b.ConnectToLobby(ctx, &v1.User{UserId: session.UserID, Username: session.Username}, helperIssueToken(t, cs, session.UserID), session); err != nil {
 		t.Error(err)
 		return
 	}
@@ -28,12 +28,16 @@ func (b *Backend) HandleJoinGame(ctx context.Context, session *bsession.Session,
 		return nil
 	}
 
-	respGame, err := b.gameClient.GetGame(ctx, connect.NewRequest(&multiv1.GetGameRequest{
+	respGame, err := b.gameClient.GetGame(ctx, bsession.NewRequest(session, &multiv1.GetGameRequest{
 		GameRoomId: data.RoomName,
 	}))
 	if err != nil {
 		return err
 	}
+	if game := respGame.Msg.GetGame(); game.GetMaxPlayers() > 0 && game.GetPlayerCount() >= game.GetMaxPlayers() {
+		slog.Info("packet-34: game room is full", "gameId", game.GetGameId(), "players", game.GetPlayerCount())
+		return session.SendToGame(packet.JoinGame, joinGameRefused)
+	}
 
 	myIpAddr, err := session.Proxy.Join(ctx, proxy.JoinParams{
 		HostUserID: respGame.Msg.GetGame().HostUserId,
@@ -43,12 +47,25 @@ func (b *Backend) HandleJoinGame(ctx context.Context, session *bsession.Session,
 	if err != nil {
 		return err
 	}
- 
-	respJoin, err := b.gameClient.JoinGame(ctx, connect.NewRequest(&multiv1.JoinGameRequest{
+
+	respJoin, err := b.gameClient.JoinGame(ctx, bsession.NewRequest(session, &multiv1.JoinGameRequest{
 		UserId:     session.UserID,
 		GameRoomId: respGame.Msg.Game.GetGameId(),
 		IpAddress:  myIpAddr.To4().String(),
 	}))
+	if err != nil {
+		// The proxy has already prepared the connections to the host.
+		session.Proxy.Close()
+	}
+	switch connect.CodeOf(err) {
+	case connect.CodePermissionDenied:
+		// The ban after being kicked by the host.
+		slog.Info("packet-34: joining the game room has been denied", "gameId", respGame.Msg.GetGame().GetGameId())
+		return session.SendToGame(packet.JoinGame, joinGameRefused)
+	case connect.CodeResourceExhausted:
+		slog.Info("packet-34: game room is full", "gameId", respGame.Msg.GetGame().GetGameId())
+		return session.SendToGame(packet.JoinGame, joinGameRefused)
+	}
 	if err != nil {
 		slog.Error("Could not join game room", logging.Error(err))
 		return nil
@@ -91,11 +108,16 @@ func (b *Backend) HandleJoinGame(ctx context.Context, session *bsession.Session,
 	return session.SendToGame(packet.JoinGame, response)
 }
 
+// joinGameRefused is answered, when the player cannot join the game room.
+// There is no known reply telling the game why, e.g. that the room is full or
+// the password is wrong, so all the refusals are answered with GameStateNone
+// instead of GameStateStarted.
+var joinGameRefused = []byte{model.GameStateNone, 0}
+
 type JoinGameRequest []byte
 
 type JoinGameRequestData struct {
 	RoomName string
-	Password string
 }
 
 func (r JoinGameRequest) Parse() (data JoinGameRequestData, err error) {
@@ -106,11 +128,12 @@ func (r JoinGameRequest) Parse() (data JoinGameRequestData, err error) {
 		return data, fmt.Errorf("packet-34: could not read room name: %w", err)
 	}
 
-	// TODO: Read password if given
+	// TODO: Read password if given. The layout of the rest of the packet is
+	// not known, the password must be located on a captured packet first.
 
 	// TODO: 216 byte at the end of the packet
 
-	return data, rd.Close()
+	return data, nil
 }
 
 type JoinGameResponse struct {
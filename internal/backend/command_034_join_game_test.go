@@ -2,14 +2,18 @@ package backend
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"connectrpc.com/connect"
 	v1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
+	"github.com/dimspell/gladiator/internal/backend/proxy"
 	"github.com/dimspell/gladiator/internal/backend/proxy/direct"
+	"github.com/dimspell/gladiator/internal/model"
 	"github.com/dimspell/gladiator/internal/wire"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestBackend_HandleJoinGame(t *testing.T) {
@@ -19,7 +23,6 @@ func TestBackend_HandleJoinGame(t *testing.T) {
 			Game: &v1.Game{
 				GameId:        "gameId",
 				Name:          "retreat",
-				Password:      "",
 				HostIpAddress: "192.168.121.212",
 				MapId:         v1.GameMap_UnderworldRetreat,
 			},
@@ -97,3 +100,86 @@ func TestBackend_HandleJoinGame(t *testing.T) {
 	assert.Equal(t, []byte{192, 168, 121, 169}, conn.Written[start+4:start+8]) // IP Address
 	assert.Equal(t, secondPlayer, conn.Written[start+8:])                      // Player name
 }
+
+func TestBackend_HandleJoinGame_RoomFull(t *testing.T) {
+	game := &v1.Game{
+		GameId:        "gameId",
+		Name:          "retreat",
+		HostIpAddress: "192.168.121.212",
+		MapId:         v1.GameMap_UnderworldRetreat,
+	}
+	request := JoinGameRequest{
+		'r', 'e', 't', 'r', 'e', 'a', 't', 0, // Game name
+		0, // Password
+	}
+
+	t.Run("listed as full", func(t *testing.T) {
+		b, _, _ := helperNewBackend(t)
+		full := proto.Clone(game).(*v1.Game)
+		full.PlayerCount, full.MaxPlayers = 4, 4
+		b.gameClient = &mockGameClient{
+			GetGameResponse: connect.NewResponse(&v1.GetGameResponse{Game: full}),
+		}
+
+		conn := &mockConn{}
+		session := &bsession.Session{ID: "TEST", Conn: conn, UserID: 2137, Username: "JP"}
+		session.Proxy = b.CreateProxy.Create(session)
+
+		assert.NoError(t, b.HandleJoinGame(t.Context(), session, request))
+		assert.Equal(t, []byte{255, 34, 6, 0, model.GameStateNone, 0}, conn.Written)
+	})
+
+	t.Run("filled up while joining", func(t *testing.T) {
+		b, _, _ := helperNewBackend(t)
+		b.gameClient = &mockGameClient{
+			GetGameResponse: connect.NewResponse(&v1.GetGameResponse{Game: game}),
+			JoinGameErr:     connect.NewError(connect.CodeResourceExhausted, errors.New("room is full")),
+		}
+
+		conn := &mockConn{}
+		session := &bsession.Session{ID: "TEST", Conn: conn, UserID: 2137, Username: "JP"}
+		lan := b.CreateProxy.Create(session).(*direct.LAN)
+		lan.GameRoom = &direct.GameRoom{ID: "gameId", Name: "gameId", Players: map[int64]wire.Player{}}
+		px := &closingProxy{ProxyClient: lan}
+		session.Proxy = px
+
+		assert.NoError(t, b.HandleJoinGame(t.Context(), session, request))
+		assert.Equal(t, []byte{255, 34, 6, 0, model.GameStateNone, 0}, conn.Written)
+		assert.True(t, px.closed, "the proxy prepared for joining is closed")
+	})
+}
+
+func TestJoinGameRequest_Parse(t *testing.T) {
+	t.Run("room name only", func(t *testing.T) {
+		data, err := JoinGameRequest("retreat\x00").Parse()
+		assert.NoError(t, err)
+		assert.Equal(t, JoinGameRequestData{RoomName: "retreat"}, data)
+	})
+
+	t.Run("unknown data at the end is ignored", func(t *testing.T) {
+		// The 216 bytes at the end of the packet, which are not known yet.
+		trailing := make([]byte, 216)
+		copy(trailing, "abc\x00")
+		trailing[100] = 0x7f
+
+		data, err := JoinGameRequest(append([]byte("retreat\x00"), trailing...)).Parse()
+		assert.NoError(t, err)
+		assert.Equal(t, JoinGameRequestData{RoomName: "retreat"}, data)
+	})
+
+	t.Run("missing room name", func(t *testing.T) {
+		_, err := JoinGameRequest("retreat").Parse()
+		assert.Error(t, err)
+	})
+}
+
+// closingProxy records whether the proxy has been closed.
+type closingProxy struct {
+	proxy.ProxyClient
+	closed bool
+}
+
+func (p *closingProxy) Close() {
+	p.closed = true
+	p.ProxyClient.Close()
+}
@@ -34,13 +34,14 @@ func (b *Backend) HandleClientAuthentication(ctx context.Context, session *bsess
 	}
 
 	// Connect to the lobby server.
-	if err = b.ConnectToLobby(ctx, user.Msg.User, session); err != nil {
+	if err = b.ConnectToLobby(ctx, user.Msg.User, user.Msg.SessionToken, session); err != nil {
 		slog.Debug("packet-41: could not connect to lobby", logging.Error(err))
 		return session.SendToGame(packet.ClientAuthentication, []byte{0, 0, 0, 0})
 	}
 
 	// Assign user into session.
 	session.SetLogonData(user.Msg.User)
+	session.RelayKey = user.Msg.RelayKey
 
 	return session.SendToGame(packet.ClientAuthentication, []byte{1, 0, 0, 0})
 }
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log/slog"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
@@ -22,8 +21,12 @@ func (b *Backend) HandleUpdateCharacterInventory(ctx context.Context, session *b
 		return nil
 	}
 
+	// The shutdown waits until the update reaches the console.
+	b.saves.Add()
+	defer b.saves.Done()
+
 	_, err = b.characterClient.PutInventoryCharacter(ctx,
-		connect.NewRequest(&multiv1.PutInventoryRequest{
+		bsession.NewRequest(session, &multiv1.PutInventoryRequest{
 			UserId:        session.UserID,
 			CharacterName: data.CharacterName,
 			Inventory:     data.Inventory,
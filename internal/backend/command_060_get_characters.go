@@ -6,7 +6,6 @@ import (
 	"encoding/binary"
 	"fmt"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
 	"github.com/dimspell/gladiator/internal/backend/packet"
@@ -18,7 +17,7 @@ func (b *Backend) HandleGetCharacters(ctx context.Context, session *bsession.Ses
 	}
 
 	resp, err := b.characterClient.ListCharacters(ctx,
-		connect.NewRequest(&multiv1.ListCharactersRequest{
+		bsession.NewRequest(session, &multiv1.ListCharactersRequest{
 			UserId: session.UserID,
 		}))
 
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log/slog"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
@@ -24,7 +23,7 @@ func (b *Backend) HandleDeleteCharacter(ctx context.Context, session *bsession.S
 	}
 
 	if _, err := b.characterClient.DeleteCharacter(ctx,
-		connect.NewRequest(&multiv1.DeleteCharacterRequest{
+		bsession.NewRequest(session, &multiv1.DeleteCharacterRequest{
 			UserId:        session.UserID,
 			CharacterName: data.CharacterName,
 		}),
@@ -32,7 +32,7 @@ func (b *Backend) HandleGetCharacterInventory(ctx context.Context, session *bses
 	}
 
 	resp, err := b.characterClient.GetCharacter(ctx,
-		connect.NewRequest(&multiv1.GetCharacterRequest{
+		bsession.NewRequest(session, &multiv1.GetCharacterRequest{
 			UserId:        session.UserID,
 			CharacterName: data.CharacterName,
 		}))
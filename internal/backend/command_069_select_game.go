@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log/slog"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
@@ -26,7 +25,7 @@ func (b *Backend) HandleSelectGame(ctx context.Context, session *bsession.Sessio
 		return nil
 	}
 
-	respGame, err := b.gameClient.GetGame(ctx, connect.NewRequest(&multiv1.GetGameRequest{
+	respGame, err := b.gameClient.GetGame(ctx, bsession.NewRequest(session, &multiv1.GetGameRequest{
 		GameRoomId: data.RoomName,
 	}))
 	if err != nil {
@@ -18,7 +18,6 @@ func TestBackend_HandleSelectGame(t *testing.T) {
 				Game: &v1.Game{
 					GameId:        "gameId",
 					Name:          "retreat",
-					Password:      "",
 					HostIpAddress: "192.168.121.212",
 					MapId:         2,
 				},
@@ -62,7 +61,6 @@ func TestBackend_HandleSelectGame(t *testing.T) {
 				Game: &v1.Game{
 					GameId:        "gameId",
 					Name:          "gameRoom",
-					Password:      "",
 					HostIpAddress: "127.0.0.28",
 					MapId:         2,
 				},
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log/slog"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
@@ -27,7 +26,7 @@ func (b *Backend) HandleShowRanking(ctx context.Context, session *bsession.Sessi
 	}
 
 	respRanking, err := b.rankingClient.GetRanking(ctx,
-		connect.NewRequest(&multiv1.GetRankingRequest{
+		bsession.NewRequest(session, &multiv1.GetRankingRequest{
 			UserId:        session.UserID,
 			CharacterName: data.CharacterName,
 			ClassType:     int64(data.ClassType),
@@ -38,7 +37,7 @@ func (b *Backend) HandleShowRanking(ctx context.Context, session *bsession.Sessi
 	}
 
 	ranking := model.RankingToBytes(respRanking.Msg)
- 
+
 	return session.SendToGame(packet.ShowRanking, ranking)
 }
 
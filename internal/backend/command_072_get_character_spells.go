@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log/slog"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
@@ -23,7 +22,7 @@ func (b *Backend) HandleGetCharacterSpells(ctx context.Context, session *bsessio
 		return nil
 	}
 
-	respChar, err := b.characterClient.GetCharacter(ctx, connect.NewRequest(&multiv1.GetCharacterRequest{
+	respChar, err := b.characterClient.GetCharacter(ctx, bsession.NewRequest(session, &multiv1.GetCharacterRequest{
 		UserId:        session.UserID,
 		CharacterName: data.CharacterName,
 	}))
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log/slog"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
@@ -23,8 +22,12 @@ func (b *Backend) HandleUpdateCharacterSpells(ctx context.Context, session *bses
 		return nil
 	}
 
+	// The shutdown waits until the update reaches the console.
+	b.saves.Add()
+	defer b.saves.Done()
+
 	_, err = b.characterClient.PutSpells(ctx,
-		connect.NewRequest(&multiv1.PutSpellsRequest{
+		bsession.NewRequest(session, &multiv1.PutSpellsRequest{
 			UserId:        session.UserID,
 			CharacterName: data.CharacterName,
 			Spells:        data.Spells,
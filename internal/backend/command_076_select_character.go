@@ -25,7 +25,7 @@ func (b *Backend) HandleSelectCharacter(ctx context.Context, session *bsession.S
 	}
 
 	respChar, err := b.characterClient.GetCharacter(ctx,
-		connect.NewRequest(&multiv1.GetCharacterRequest{
+		bsession.NewRequest(session, &multiv1.GetCharacterRequest{
 			UserId:        session.UserID,
 			CharacterName: data.CharacterName,
 		}))
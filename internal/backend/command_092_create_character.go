@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log/slog"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
@@ -25,7 +24,7 @@ func (b *Backend) HandleCreateCharacter(ctx context.Context, session *bsession.S
 	}
 
 	respChar, err := b.characterClient.CreateCharacter(ctx,
-		connect.NewRequest(&multiv1.CreateCharacterRequest{
+		bsession.NewRequest(session, &multiv1.CreateCharacterRequest{
 			UserId:        session.UserID,
 			CharacterName: data.CharacterName,
 			Stats:         data.Info,
@@ -44,7 +43,7 @@ func (b *Backend) HandleCreateCharacter(ctx context.Context, session *bsession.S
 
 // TODO: check if there is any additional not recognised byte at the end like slot number
 type CreateCharacterRequest []byte
-  
+
 type CreateCharacterRequestData struct {
 	Info          []byte
 	ParsedInfo    model.CharacterInfo
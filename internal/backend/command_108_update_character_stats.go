@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log/slog"
 
-	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
@@ -28,8 +27,12 @@ func (b *Backend) HandleUpdateCharacterStats(ctx context.Context, session *bsess
 		return err
 	}
 
+	// The shutdown waits until the update reaches the console.
+	b.saves.Add()
+	defer b.saves.Done()
+
 	_, err = b.characterClient.PutStats(context.TODO(),
-		connect.NewRequest(&multiv1.PutStatsRequest{
+		bsession.NewRequest(session, &multiv1.PutStatsRequest{
 			UserId:        session.UserID,
 			CharacterName: data.Character,
 			Stats:         data.Info,
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
@@ -13,11 +14,14 @@ import (
 
 type LobbyEventHandler struct {
 	Session *bsession.Session
+
+	// Drain is called, when the console asks the backend to shut down.
+	Drain func(notice wire.Drain)
 }
 
 // NewLobbyEventHandler creates a new LobbyEventHandler for the given Session.
 func NewLobbyEventHandler(session *bsession.Session) *LobbyEventHandler {
-	return &LobbyEventHandler{session}
+	return &LobbyEventHandler{Session: session}
 }
 
 func (h *LobbyEventHandler) Handle(ctx context.Context, payload []byte) error {
@@ -35,15 +39,104 @@ func (h *LobbyEventHandler) Handle(ctx context.Context, payload []byte) error {
 			slog.Error("Error writing chat message over the backend wire", "session", h.Session.ID, logging.Error(err))
 			return nil
 		}
-	case wire.LobbyUsers:
-		// TODO: Handle it. Note: It should be sent only once.
-		_, msg, err := wire.DecodeTyped[[]wire.Player](payload)
+	case wire.SystemMessage:
+		_, msg, err := wire.DecodeTyped[wire.ChatMessage](payload)
+		if err != nil {
+			slog.Warn("Could not decode the message", "session", h.Session.ID, logging.Error(err), "event", eventType.String(), "payload", payload)
+			return nil
+		}
+		// Global messages are displayed both in the lobby and in the game.
+		if err := h.Session.SendToGame(packet.ReceiveMessage, NewGlobalMessage(msg.Content.User, msg.Content.Text)); err != nil {
+			slog.Error("Error writing system message over the backend wire", "session", h.Session.ID, logging.Error(err))
+			return nil
+		}
+	case wire.PrivateMessage:
+		_, msg, err := wire.DecodeTyped[wire.Whisper](payload)
 		if err != nil {
 			slog.Warn("Could not decode the message", "session", h.Session.ID, logging.Error(err), "event", eventType.String(), "payload", payload)
 			return nil
 		}
 
-		h.Session.State.UpdateLobbyUsers(msg.Content)
+		// The sender receives the copy of the message, once it is delivered.
+		var resp []byte
+		if msg.Content.From == h.Session.Username {
+			resp = NewPrivateMessage(msg.Content.To, msg.Content.Text, true)
+		} else {
+			resp = NewPrivateMessage(msg.Content.From, msg.Content.Text, false)
+		}
+		if err := h.Session.SendToGame(packet.ReceiveMessage, resp); err != nil {
+			slog.Error("Error writing private message over the backend wire", "session", h.Session.ID, logging.Error(err))
+			return nil
+		}
+	case wire.PrivateMessageFailed:
+		_, msg, err := wire.DecodeTyped[wire.Whisper](payload)
+		if err != nil {
+			slog.Warn("Could not decode the message", "session", h.Session.ID, logging.Error(err), "event", eventType.String(), "payload", payload)
+			return nil
+		}
+		text := fmt.Sprintf("%s is not online", msg.Content.To)
+		if err := h.Session.SendToGame(packet.ReceiveMessage, NewLobbyMessage("system-info", text)); err != nil {
+			slog.Error("Error writing system message over the backend wire", "session", h.Session.ID, logging.Error(err))
+			return nil
+		}
+	case wire.JoinedChannel:
+		_, msg, err := wire.DecodeTyped[wire.ChannelRoster](payload)
+		if err != nil {
+			slog.Warn("Could not decode the message", "session", h.Session.ID, logging.Error(err), "event", eventType.String(), "payload", payload)
+			return nil
+		}
+
+		previous := h.Session.State.GetChannel()
+		previousUsers := h.Session.State.GetLobbyUsers()
+		h.Session.State.SetChannel(msg.Content.Channel)
+		h.Session.State.SetMOTD(msg.Content.MOTD)
+		h.Session.State.UpdateLobbyUsers(msg.Content.Players)
+
+		// The players of the channel entered after connecting to the lobby are
+		// listed once the game selects the channel.
+		if previous == "" {
+			return nil
+		}
+
+		for _, player := range previousUsers {
+			if err := h.Session.SendToGame(packet.ReceiveMessage, RemoveCharacterFromLobby(player.Username)); err != nil {
+				slog.Warn("Error removing lobby user", "session", h.Session.ID, logging.Error(err))
+				return nil
+			}
+		}
+		if err := h.Session.SendToGame(packet.ReceiveMessage, SetChannelName(msg.Content.Channel)); err != nil {
+			slog.Warn("Error setting channel name", "session", h.Session.ID, logging.Error(err))
+			return nil
+		}
+		if msg.Content.MOTD != "" {
+			if err := h.Session.SendToGame(packet.ReceiveMessage, NewLobbyMessage("system-info", msg.Content.MOTD)); err != nil {
+				slog.Warn("Error writing message of the day", "session", h.Session.ID, logging.Error(err))
+				return nil
+			}
+		}
+		for idx, player := range msg.Content.Players {
+			if err := h.Session.SendToGame(packet.ReceiveMessage, AppendCharacterToLobby(player.Username, model.ClassType(player.ClassType), uint32(idx))); err != nil {
+				slog.Warn("Error appending lobby user", "session", h.Session.ID, logging.Error(err))
+				return nil
+			}
+		}
+	case wire.JoinChannelRejected:
+		_, msg, err := wire.DecodeTyped[wire.ChannelRejection](payload)
+		if err != nil {
+			slog.Warn("Could not decode the message", "session", h.Session.ID, logging.Error(err), "event", eventType.String(), "payload", payload)
+			return nil
+		}
+
+		// Restore the name of the channel the user is still present in.
+		if err := h.Session.SendToGame(packet.ReceiveMessage, SetChannelName(h.Session.State.GetChannel())); err != nil {
+			slog.Warn("Error setting channel name", "session", h.Session.ID, logging.Error(err))
+			return nil
+		}
+		text := fmt.Sprintf("Cannot join the channel %s: %s", msg.Content.Channel, msg.Content.Reason)
+		if err := h.Session.SendToGame(packet.ReceiveMessage, NewLobbyMessage("system-info", text)); err != nil {
+			slog.Warn("Error writing system message", "session", h.Session.ID, logging.Error(err))
+			return nil
+		}
 	case wire.JoinLobby:
 		_, msg, err := wire.DecodeTyped[wire.Player](payload)
 		if err != nil {
@@ -76,6 +169,15 @@ func (h *LobbyEventHandler) Handle(ctx context.Context, payload []byte) error {
 			slog.Warn("Error appending lobby user", "session", h.Session.ID, logging.Error(err))
 			return nil
 		}
+	case wire.DrainBackend:
+		_, msg, err := wire.DecodeTyped[wire.Drain](payload)
+		if err != nil {
+			slog.Warn("Could not decode the message", "session", h.Session.ID, logging.Error(err), "event", eventType.String(), "payload", payload)
+			return nil
+		}
+		if h.Drain != nil {
+			h.Drain(msg.Content)
+		}
 	default:
 		// Skip and do not handle it.
 	}
@@ -141,6 +141,12 @@ func (p *LAN) Handle(ctx context.Context, payload []byte) error {
 		}
 
 		player := msg.Content
+		if player.UserID == p.Session.UserID {
+			// The host has kicked the current player out of the room.
+			slog.Info("Current player has been removed from the game room")
+			p.GameRoom = nil
+			return nil
+		}
 		slog.Info("Other player is leaving", "playerId", player.ID())
 
 		gameRoom, found := p.GameRoom, p.GameRoom != nil
@@ -18,6 +18,7 @@ type PeerManager interface {
 	GetPeer(peerId int64) (*Peer, bool)
 	RemovePeer(peerId int64)
 	CreatePeer(player wire.Player) (*Peer, error)
+	Reset()
 
 	Host() (*Peer, bool)
 	SetHost(newHostPeer *Peer, newHost wire.Player)
@@ -56,7 +57,7 @@ func (h *PeerToPeerMessageHandler) Handle(ctx context.Context, payload []byte) e
 	eventType := wire.ParseEventType(payload)
 
 	switch eventType {
-	case wire.LobbyUsers, wire.JoinLobby, wire.CreateRoom:
+	case wire.LobbyUsers, wire.JoinLobby, wire.JoinedChannel, wire.CreateRoom:
 		return nil
 	case wire.JoinRoom:
 		return decodeAndHandle(ctx, payload, wire.JoinRoom.String(), h.handleJoinRoom)
@@ -263,6 +264,13 @@ func (h *PeerToPeerMessageHandler) handleRTCCandidate(ctx context.Context, candi
 }
 
 func (h *PeerToPeerMessageHandler) handleLeaveRoom(ctx context.Context, player wire.Player) error {
+	if player.UserID == h.UserID {
+		// The host has kicked the current player out of the room, so the
+		// connections to all the other players are closed.
+		h.logger.Info("Current player has been removed from the game room")
+		h.peerManager.Reset()
+		return nil
+	}
 	h.logger.Info("Other player is leaving", "playerId", player.ID())
 
 	peer, ok := h.peerManager.GetPeer(player.UserID)
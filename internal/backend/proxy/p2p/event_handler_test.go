@@ -98,6 +98,11 @@ func (m *mockPeerManager) CreatePeer(player wire.Player) (*Peer, error) {
 	}, nil
 }
 
+func (m *mockPeerManager) Reset() {
+	clear(m.peers)
+	m.host = nil
+}
+
 func (m *mockPeerManager) Host() (*Peer, bool) {
 	return m.host, true
 }
@@ -461,6 +466,26 @@ func TestPeerToPeerMessageHandler_handleLeave(t *testing.T) {
 		_, ok := peerManager.peers[leavingPlayer.UserID]
 		assert.False(t, ok, "Peer should be removed from peerManager")
 	})
+
+	t.Run("Kicked by the host", func(t *testing.T) {
+		peerManager := &mockPeerManager{
+			host: &Peer{UserID: 1},
+			peers: map[int64]*Peer{
+				1: {UserID: 1},
+				3: {UserID: 3},
+			},
+		}
+		h := &PeerToPeerMessageHandler{
+			UserID:      2,
+			session:     &mockSession{ID: 2},
+			peerManager: peerManager,
+			logger:      slog.Default(),
+		}
+
+		assert.NoError(t, h.handleLeaveRoom(t.Context(), wire.Player{UserID: 2}))
+		assert.Empty(t, peerManager.peers, "All peers should be removed")
+		assert.Nil(t, peerManager.host)
+	})
 }
 
 func TestPeerToPeerMessageHandler_handleHostMigration(t *testing.T) {
@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"net"
 	"sync"
+
+	"github.com/dimspell/gladiator/internal/model"
 )
 
 const (
-	ringSize      = 3
+	// ringSize is the number of the addresses of the other players in the game
+	// room, the host excluded.
+	ringSize      = model.MaxGamePlayers - 1
 	ipStart       = 2
 	localhost     = "127.0.0.1"
 	maxPortNumber = 65535
@@ -84,7 +84,7 @@ func (p *PeerToPeer) CreateRoom(params proxy.CreateParams) (net.IP, error) {
 	gameRoom := &Game{
 		ID:     params.GameID,
 		Host:   hostPlayer,
-		Peers:  map[int64]*Peer{}, // FIXME: Add size limit
+		Peers:  make(map[int64]*Peer, model.MaxGamePlayers-1), // The size is limited by the console
 		IpRing: NewIpRing(),
 	}
 
@@ -120,7 +120,7 @@ func (p *PeerToPeer) SelectGame(params proxy.GameData) error {
 	gameRoom := &Game{
 		ID:     params.Game.GameId,
 		Host:   hostPlayer,
-		Peers:  map[int64]*Peer{}, // FIXME: Add size limit
+		Peers:  make(map[int64]*Peer, model.MaxGamePlayers-1), // The size is limited by the console
 		IpRing: NewIpRing(),
 	}
 
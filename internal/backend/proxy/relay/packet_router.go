@@ -1,8 +1,9 @@
 package relay
 
 import (
-	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
@@ -28,12 +29,19 @@ type PacketRouter struct {
 	session   *bsession.Session
 	selfID    string
 	relayAddr string
+	tlsConfig *tls.Config
 
 	roomID        string
 	currentHostID string
 	relayConn     *quic.Conn
 	stream        *quic.Stream
 	pingTicker    *time.Ticker
+
+	// writeMu guards the writes to the stream, so the packets are sent in the
+	// order of their sequence numbers.
+	writeMu sync.Mutex
+	sendSeq uint64
+	recvSeq uint64
 }
 
 func (r *PacketRouter) Reset() {
@@ -47,6 +55,7 @@ func (r *PacketRouter) Reset() {
 	if r.relayConn != nil {
 		_ = r.stream.Close()
 		_ = r.relayConn.CloseWithError(0, "done")
+		r.relayConn = nil
 	}
 
 	r.manager.StopAll()
@@ -93,6 +102,10 @@ func (r *PacketRouter) handleJoinRoom(ctx context.Context, player wire.Player) e
 func (r *PacketRouter) handleLeaveRoom(ctx context.Context, player wire.Player) error {
 	peerID := remoteID(player.UserID)
 	if r.selfID == peerID {
+		// The host has kicked the current player out of the room, so all the
+		// redirects to the other players are stopped.
+		r.logger.Info("removed from the game room, stopping all redirects")
+		r.Reset()
 		return nil
 	}
 
@@ -210,11 +223,7 @@ func (r *PacketRouter) connect(ctx context.Context, roomID string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	tlsConf := &tls.Config{
-		InsecureSkipVerify: true,
-		NextProtos:         []string{"game-relay"},
-	}
-	conn, err := quic.DialAddr(ctx, r.relayAddr, tlsConf, &quic.Config{
+	conn, err := quic.DialAddr(ctx, r.relayAddr, r.tlsConfig, &quic.Config{
 		MaxIdleTimeout:  30 * time.Second,
 		KeepAlivePeriod: 15 * time.Second,
 	})
@@ -227,25 +236,34 @@ func (r *PacketRouter) connect(ctx context.Context, roomID string) error {
 	if err != nil {
 		return fmt.Errorf("quic open stream failed: %w", err)
 	}
+
+	r.writeMu.Lock()
 	r.stream = stream
+	r.sendSeq, r.recvSeq = 0, 0
+	r.writeMu.Unlock()
 
-	// Send "join" packet
+	// Send "join" packet, the session token lets the relay server derive the
+	// key to verify the signatures with.
 	if err := r.sendPacket(RelayPacket{
-		Type:   "join",
-		RoomID: roomID,
+		Type:    "join",
+		RoomID:  roomID,
+		Payload: []byte(r.session.Token),
 	}); err != nil {
 		return fmt.Errorf("send join packet failed: %w", err)
 	}
 
-	// Make sure the QUIC send the only packet, not joined with others.
-	time.Sleep(100 * time.Millisecond)
-
 	// Start receiver
 	go r.receiveLoop(ctx, stream)
 
 	return nil
 }
 
+func (r *PacketRouter) isConnected() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.relayConn != nil
+}
+
 func (r *PacketRouter) keepAliveHost(ctx context.Context) {
 	r.mu.Lock()
 	if r.pingTicker != nil {
@@ -293,26 +311,37 @@ func (r *PacketRouter) stop(host *redirect.FakeHost, peerID string, ipAddress st
 	r.manager.StopHost(host, ipAddress)
 }
 
-var hmacKey = []byte("shared-secret-key")
+// sign computes the HMAC-SHA256 signature of the relay packet using the
+// per-session relay key and stores it in the packet.
+func sign(key []byte, pkt *RelayPacket) error {
+	pkt.Signature = nil
+	data, err := json.Marshal(pkt)
+	if err != nil {
+		return err
+	}
 
-func sign(data []byte) []byte {
-	// mac := hmac.New(sha256.New, hmacKey)
-	// mac.Write(data)
-	// return append(mac.Sum(nil), data...)
-	return data
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	pkt.Signature = mac.Sum(nil)
+	return nil
 }
 
-func verify(packet []byte) ([]byte, bool) {
-	// if len(packet) < 32 {
-	//	return nil, false
-	// }
-	// sig := packet[:32]
-	// data := packet[32:]
-	// mac := hmac.New(sha256.New, hmacKey)
-	// mac.Write(data)
-	// expected := mac.Sum(nil)
-	// return data, hmac.Equal(sig, expected)
-	return packet, true
+// verify checks whether the relay packet has been signed with the given key.
+func verify(key []byte, pkt RelayPacket) bool {
+	if len(key) == 0 || len(pkt.Signature) != sha256.Size {
+		return false
+	}
+
+	signature := pkt.Signature
+	pkt.Signature = nil
+	data, err := json.Marshal(pkt)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hmac.Equal(signature, mac.Sum(nil))
 }
 
 type RelayPacket struct {
@@ -321,9 +350,18 @@ type RelayPacket struct {
 	FromID  string `json:"from"`
 	ToID    string `json:"to,omitempty"`
 	Payload []byte `json:"payload"`
+
+	// Seq is a sequence number of the packet sent over the stream.
+	Seq uint64 `json:"seq"`
+
+	// Signature is an HMAC-SHA256 of the packet (without the signature).
+	Signature []byte `json:"sig,omitempty"`
 }
 
 func (r *PacketRouter) sendPacket(pkt RelayPacket) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
 	if r.stream == nil {
 		return fmt.Errorf("stream is nil")
 	}
@@ -331,11 +369,16 @@ func (r *PacketRouter) sendPacket(pkt RelayPacket) error {
 	// Always associate who sending the packet
 	pkt.FromID = r.selfID
 
+	r.sendSeq++
+	pkt.Seq = r.sendSeq
+	if err := sign(r.session.RelayKey, &pkt); err != nil {
+		return fmt.Errorf("sign packet failed: %w", err)
+	}
+
 	data, err := json.Marshal(pkt)
 	if err != nil {
 		return fmt.Errorf("marshal packet failed: %w", err)
 	}
-	// packet := sign(data)
 
 	// r.logger.Debug("Sending packet", "fromID", pkt.FromID, "type", pkt.Type, "data", pkt.Payload, "datastr", string(pkt.Payload), "toId", pkt.ToID)
 
@@ -349,57 +392,49 @@ func (r *PacketRouter) sendPacket(pkt RelayPacket) error {
 }
 
 func (r *PacketRouter) receiveLoop(ctx context.Context, stream *quic.Stream) {
-	buf := make([]byte, 4096)
+	d := json.NewDecoder(stream)
 	for {
-		n, err := stream.Read(buf)
-		if err == io.EOF {
-			return
-		}
-		if err != nil {
+		var pkt RelayPacket
+		if err := d.Decode(&pkt); err != nil {
+			if err == io.EOF {
+				return
+			}
 			r.logger.Error("received error while reading packet", logging.Error(err))
 			return
 		}
-		data, ok := verify(buf[:n])
-		if !ok {
+
+		if !verify(r.session.RelayKey, pkt) {
 			r.logger.Warn("received invalid packet - signature is incorrect")
 			continue
 		}
+		if pkt.Seq <= r.recvSeq {
+			r.logger.Warn("received replayed packet", "seq", pkt.Seq, "lastSeq", r.recvSeq)
+			continue
+		}
+		r.recvSeq = pkt.Seq
 
-		// r.logger.Debug("Received packet", "data", data, "datastr", string(data))
-
-		d := json.NewDecoder(bytes.NewReader(data))
-		for {
-			var pkt RelayPacket
-			if err := d.Decode(&pkt); err != nil {
-				if err == io.EOF {
-					break
-				}
-				r.logger.Warn("failed to unmarshal packet", logging.Error(err))
-				break
-			}
-
-			switch pkt.Type {
-			case "join":
-				r.dynamicJoin(ctx, pkt.RoomID, pkt.FromID, pkt)
+		switch pkt.Type {
+		case "join":
+			r.dynamicJoin(ctx, pkt.RoomID, pkt.FromID, pkt)
 
-			case "data":
-				r.readMessage(pkt.FromID, pkt)
+		case "data":
+			r.readMessage(pkt.FromID, pkt)
 
-			case "tcp":
-				r.writeTCP(pkt.FromID, pkt)
+		case "tcp":
+			r.writeTCP(pkt.FromID, pkt)
 
-			case "udp":
-				r.writeUDP(pkt.FromID, pkt)
+		case "udp":
+			r.writeUDP(pkt.FromID, pkt)
 
-			case "broadcast":
-				r.readBroadcast(pkt.FromID, pkt)
+		case "broadcast":
+			r.readBroadcast(pkt.FromID, pkt)
 
-			case "leave":
-				r.leaveRoom(pkt.FromID)
-			}
+		case "leave":
+			r.leaveRoom(pkt.FromID)
 		}
 	}
 }
+
 func (r *PacketRouter) readBroadcast(fromID string, pkt RelayPacket) {
 	r.logger.Info("broadcast packet received", slog.String("fromID", fromID), slog.String("payload", string(pkt.Payload)))
 }
@@ -2,9 +2,15 @@ package relay
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
 	"net"
+	"os"
+	"strings"
 
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
@@ -24,9 +30,62 @@ type ProxyRelay struct {
 	// which the proxy will forward all client traffic.
 	RelayServerAddr string
 
+	// RootCAs are used to verify the certificate of the relay server. When
+	// nil, the certificate is verified against the pinned Fingerprint, or
+	// the system roots if there is no fingerprint either.
+	RootCAs *x509.CertPool
+
+	// Fingerprint is the hex-encoded SHA-256 hash of the relay server
+	// certificate, as announced in /.well-known/console.json.
+	Fingerprint string
+
 	IPPrefix net.IP
 }
 
+// TLSConfig returns the configuration used to connect to the relay server.
+func (p *ProxyRelay) TLSConfig() *tls.Config {
+	conf := &tls.Config{
+		NextProtos: []string{"game-relay"},
+		MinVersion: tls.VersionTLS13,
+	}
+	if host, _, err := net.SplitHostPort(p.RelayServerAddr); err == nil {
+		conf.ServerName = host
+	}
+
+	if p.RootCAs == nil && p.Fingerprint != "" {
+		// The chain is not verified, the certificate must match the pinned
+		// fingerprint instead.
+		conf.InsecureSkipVerify = true
+		conf.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return fmt.Errorf("relay server has not presented any certificate")
+			}
+			sum := sha256.Sum256(state.PeerCertificates[0].Raw)
+			if !strings.EqualFold(hex.EncodeToString(sum[:]), p.Fingerprint) {
+				return fmt.Errorf("relay server certificate does not match the pinned fingerprint")
+			}
+			return nil
+		}
+		return conf
+	}
+
+	conf.RootCAs = p.RootCAs
+	return conf
+}
+
+// LoadCertPool reads the PEM-encoded CA certificates from the file.
+func LoadCertPool(caFile string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %q", caFile)
+	}
+	return pool, nil
+}
+
 func (p *ProxyRelay) Mode() model.RunMode { return model.RunModeRelay }
 
 func (p *ProxyRelay) Create(session *bsession.Session) proxy.ProxyClient {
@@ -52,6 +111,7 @@ func NewRelay(config *ProxyRelay, session *bsession.Session) *Relay {
 
 	router := &PacketRouter{
 		relayAddr: config.RelayServerAddr,
+		tlsConfig: config.TLSConfig(),
 		logger:    slog.With(slog.String("proxy", "relay"), slog.String("sessionId", session.ID)),
 		selfID:    remoteID(session.UserID),
 		session:   session,
@@ -71,22 +131,21 @@ func (r *Relay) GetHostIP(ip net.IP) net.IP {
 }
 
 func (r *Relay) CreateRoom(params proxy.CreateParams) (net.IP, error) {
-	ctx := context.Background()
-	roomID := params.GameID
-
 	r.router.Reset()
 	r.router.selfID = remoteID(r.session.UserID)
 	r.router.currentHostID = remoteID(r.session.UserID)
-	r.router.roomID = roomID
-
-	if err := r.router.connect(ctx, roomID); err != nil {
-		return nil, fmt.Errorf("failed connect to the relay server: %w", err)
-	}
+	r.router.roomID = params.GameID
 
 	return net.IPv4(127, 0, 0, 1), nil
 }
 
 func (r *Relay) HostRoom(ctx context.Context, params proxy.HostParams) error {
+	// The relay server accepts only the members of the game room, so the
+	// connection is made after the room has been created in the console.
+	if err := r.router.connect(ctx, params.GameID); err != nil {
+		return fmt.Errorf("failed connect to the relay server: %w", err)
+	}
+
 	if err := r.session.SendSetRoomReady(ctx, params.GameID); err != nil {
 		return fmt.Errorf("could not send set room ready: %w", err)
 	}
@@ -152,20 +211,12 @@ func (r *Relay) GetPlayerAddr(params proxy.GetPlayerAddrParams) (net.IP, error)
 	return ipv4, nil
 }
 
+// Join starts the local proxies for the peers of the game room. The
+// connection to the relay server is deferred to ConnectToPlayer, because the
+// relay server accepts only the players, who have already joined the game room
+// in the console.
 func (r *Relay) Join(ctx context.Context, params proxy.JoinParams) (net.IP, error) {
 	roomID := params.GameID
-	if err := r.router.connect(ctx, roomID); err != nil {
-		return nil, fmt.Errorf("failed connect to the relay server: %w", err)
-	}
-
-	if err := r.router.sendPacket(RelayPacket{
-		Type:    "broadcast",
-		RoomID:  roomID,
-		Payload: []byte("Hello everyone!"),
-	}); err != nil {
-		return nil, err
-	}
-
 	hostID := remoteID(params.HostUserID)
 
 	for peerID, ipAddress := range r.router.manager.PeerIPs {
@@ -214,6 +265,20 @@ func (r *Relay) Join(ctx context.Context, params proxy.JoinParams) (net.IP, erro
 }
 
 func (r *Relay) ConnectToPlayer(ctx context.Context, params proxy.GetPlayerAddrParams) (net.IP, error) {
+	if !r.router.isConnected() {
+		if err := r.router.connect(ctx, params.GameID); err != nil {
+			return nil, fmt.Errorf("failed connect to the relay server: %w", err)
+		}
+
+		if err := r.router.sendPacket(RelayPacket{
+			Type:    "broadcast",
+			RoomID:  params.GameID,
+			Payload: []byte("Hello everyone!"),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	return r.GetPlayerAddr(params)
 }
 
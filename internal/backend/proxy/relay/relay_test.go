@@ -0,0 +1,107 @@
+package relay
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func helperCertificate(t *testing.T) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "relay.example.com"},
+		DNSNames:     []string{"relay.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// helperHandshake runs the TLS handshake against the server presenting the
+// certificate and returns the error of the client side.
+func helperHandshake(t *testing.T, cert tls.Certificate, conf *tls.Config) error {
+	t.Helper()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"game-relay"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		_ = conn.(*tls.Conn).HandshakeContext(t.Context())
+		_ = conn.Close()
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	return tls.Client(conn, conf).HandshakeContext(t.Context())
+}
+
+func TestProxyRelay_TLSConfig(t *testing.T) {
+	cert := helperCertificate(t)
+	sum := sha256.Sum256(cert.Certificate[0])
+	fingerprint := hex.EncodeToString(sum[:])
+
+	t.Run("pinned fingerprint", func(t *testing.T) {
+		px := &ProxyRelay{RelayServerAddr: "203.0.113.1:9999", Fingerprint: fingerprint}
+		assert.NoError(t, helperHandshake(t, cert, px.TLSConfig()))
+	})
+
+	t.Run("other fingerprint", func(t *testing.T) {
+		other := helperCertificate(t)
+		px := &ProxyRelay{RelayServerAddr: "203.0.113.1:9999", Fingerprint: fingerprint}
+		assert.ErrorContains(t, helperHandshake(t, other, px.TLSConfig()), "pinned fingerprint")
+	})
+
+	t.Run("certificate authority", func(t *testing.T) {
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool := x509.NewCertPool()
+		pool.AddCert(leaf)
+
+		px := &ProxyRelay{RelayServerAddr: "relay.example.com:9999", RootCAs: pool}
+		assert.NoError(t, helperHandshake(t, cert, px.TLSConfig()))
+
+		px = &ProxyRelay{RelayServerAddr: "relay.example.com:9999", RootCAs: x509.NewCertPool()}
+		assert.Error(t, helperHandshake(t, cert, px.TLSConfig()))
+	})
+
+	t.Run("without fingerprint nor CA", func(t *testing.T) {
+		px := &ProxyRelay{RelayServerAddr: "relay.example.com:9999"}
+		assert.Error(t, helperHandshake(t, cert, px.TLSConfig()), "self-signed certificate must not be trusted")
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	v1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger"
@@ -43,7 +44,9 @@ func TestE2E_LAN(t *testing.T) {
 		Multiplayer: console.NewMultiplayer(),
 		Config:      console.DefaultConfig(),
 		DB:          db,
+		Bans:        console.NewBanList(db, time.Now),
 	}
+	cs.Multiplayer.Channels = console.NewChannelList(db)
 	ts := httptest.NewServer(cs.HttpRouter())
 	defer ts.Close()
 	// go cs.Multiplayer.Run(ctx)
@@ -99,14 +102,18 @@ func TestE2E_LAN(t *testing.T) {
 		0, // Password
 	}))
 
-	cs.Multiplayer.HandleIncomingMessage(ctx, <-cs.Multiplayer.Messages)
+	// The host opens the room through the lobby.
+	assert.Eventually(t, func() bool {
+		room, ok := cs.Multiplayer.GetRoom("room")
+		return ok && room.State == v1.GameState_GameStateOpen
+	}, time.Second, 10*time.Millisecond)
 
 	room, ok := cs.Multiplayer.Rooms["room"]
 	if !ok {
 		t.Errorf("failed to find room")
 		return
 	}
-	if !room.Ready {
+	if room.State != v1.GameState_GameStateOpen {
 		t.Errorf("failed to create new room - it is unready")
 		return
 	}
@@ -208,7 +215,7 @@ func TestE2E_LAN(t *testing.T) {
 		t.Errorf("failed to find room")
 		return
 	}
-	if !room.Ready {
+	if room.State != v1.GameState_GameStateOpen {
 		t.Errorf("failed to join room - it is unready")
 		return
 	}
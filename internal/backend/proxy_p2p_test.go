@@ -53,7 +53,9 @@ func TestE2E_P2P(t *testing.T) {
 		Multiplayer: console.NewMultiplayer(),
 		Config:      console.DefaultConfig(),
 		DB:          db,
+		Bans:        console.NewBanList(db, time.Now),
 	}
+	cs.Multiplayer.Channels = console.NewChannelList(db)
 	ts := httptest.NewServer(cs.HttpRouter())
 	defer ts.Close()
 
@@ -115,14 +117,18 @@ func TestE2E_P2P(t *testing.T) {
 		0, // Password
 	}))
 
-	cs.Multiplayer.HandleIncomingMessage(ctx, <-cs.Multiplayer.Messages)
+	// The host opens the room through the lobby.
+	assert.Eventually(t, func() bool {
+		room, ok := cs.Multiplayer.GetRoom("room")
+		return ok && room.State == v1.GameState_GameStateOpen
+	}, time.Second, 10*time.Millisecond)
 
 	room, ok := cs.Multiplayer.Rooms["room"]
 	if !ok {
 		t.Errorf("failed to find room")
 		return
 	}
-	if !room.Ready {
+	if room.State != v1.GameState_GameStateOpen {
 		t.Errorf("failed to create new room - it is unready")
 		return
 	}
@@ -229,7 +235,7 @@ func TestE2E_P2P(t *testing.T) {
 		t.Errorf("failed to find room")
 		return
 	}
-	if !room.Ready {
+	if room.State != v1.GameState_GameStateOpen {
 		t.Errorf("failed to join room - it is unready")
 		return
 	}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"net"
+	"time"
 
 	"github.com/coder/websocket"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
@@ -38,13 +39,16 @@ func (b *Backend) CloseSession(session *bsession.Session) error {
 	return nil
 }
 
-func (b *Backend) ConnectToLobby(ctx context.Context, user *multiv1.User, session *bsession.Session) error {
-	return session.ConnectOverWebsocket(ctx, user, b.SignalServerURL)
+func (b *Backend) ConnectToLobby(ctx context.Context, user *multiv1.User, token string, session *bsession.Session) error {
+	return session.ConnectOverWebsocket(ctx, user, token, b.SignalServerURL)
 }
 
 func (b *Backend) RegisterNewObserver(ctx context.Context, session *bsession.Session) error {
+	lobbyHandler := NewLobbyEventHandler(session)
+	lobbyHandler.Drain = b.drainRequested
+
 	handlers := []proxy.MessageHandler{
-		NewLobbyEventHandler(session).Handle,
+		lobbyHandler.Handle,
 		session.Proxy.Handle,
 	}
 	observe := func(ctx context.Context, wsConn *websocket.Conn) {
@@ -59,8 +63,11 @@ func (b *Backend) RegisterNewObserver(ctx context.Context, session *bsession.Ses
 				if errors.Is(err, context.Canceled) {
 					return
 				}
-				slog.Error("Error reading from WebSocket", "session", session.ID, logging.Error(err))
-				return
+				slog.Warn("Lost the connection to the lobby", "session", session.ID, logging.Error(err))
+				if !b.reconnectLobby(session) {
+					return
+				}
+				continue
 			}
 
 			// slog.Debug("Signal from lobby", "type", et.String(), "session", session.ID, "payload", string(p[1:]))
@@ -77,6 +84,53 @@ func (b *Backend) RegisterNewObserver(ctx context.Context, session *bsession.Ses
 	return session.StartObserver(ctx, observe)
 }
 
+// ReconnectPolicy describes how often the backend tries to connect to the
+// lobby again, after the connection has been lost.
+type ReconnectPolicy struct {
+	// MinDelay is the time before the first attempt. It is doubled after
+	// every failed attempt.
+	MinDelay time.Duration
+
+	// MaxDelay limits the time between the attempts.
+	MaxDelay time.Duration
+}
+
+var DefaultReconnectPolicy = ReconnectPolicy{
+	MinDelay: time.Second,
+	MaxDelay: 30 * time.Second,
+}
+
+// reconnectLobby connects the session to the lobby again, e.g. after the
+// console has been restarted. It retries until it succeeds or the session is
+// stopped, and reports whether the session is connected.
+func (b *Backend) reconnectLobby(session *bsession.Session) bool {
+	policy := b.Reconnect
+	if policy.MinDelay <= 0 {
+		policy = DefaultReconnectPolicy
+	}
+
+	delay := policy.MinDelay
+	for {
+		select {
+		case <-session.LobbyDone():
+			return false
+		case <-time.After(delay):
+		}
+
+		err := session.ReconnectLobby()
+		if err == nil {
+			slog.Info("Reconnected to the lobby", "session", session.ID)
+			return true
+		}
+		if errors.Is(err, context.Canceled) {
+			return false
+		}
+		slog.Warn("Could not reconnect to the lobby", "session", session.ID, "retry", delay, logging.Error(err))
+
+		delay = min(2*delay, policy.MaxDelay)
+	}
+}
+
 type Proxy interface {
 	// Create creates a proxy for the session
 	Create(session *bsession.Session) proxy.ProxyClient
@@ -3,6 +3,10 @@ package backend
 import (
 	"context"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -10,6 +14,9 @@ import (
 	"github.com/dimspell/gladiator/internal/app/logger"
 	"github.com/dimspell/gladiator/internal/backend/bsession"
 	"github.com/dimspell/gladiator/internal/backend/proxy/direct"
+	"github.com/dimspell/gladiator/internal/console"
+	"github.com/dimspell/gladiator/internal/console/auth"
+	"github.com/dimspell/gladiator/internal/console/database"
 	"github.com/dimspell/gladiator/internal/model"
 	"github.com/stretchr/testify/assert"
 )
@@ -22,11 +29,11 @@ func TestBackend_RegisterNewObserver(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	b, _, _ := helperNewBackend(t)
+	b, _, cs := helperNewBackend(t)
 	conn := &mockConn{RemoteAddress: &net.IPAddr{IP: net.ParseIP("127.0.0.1")}}
 	session := &bsession.Session{ID: "TEST", Conn: conn, UserID: 2137, Username: "JP"}
 
-	if err := b.ConnectToLobby(ctx, &v1.User{UserId: session.UserID, Username: session.Username}, session); err != nil {
+	if err := b.ConnectToLobby(ctx, &v1.User{UserId: session.UserID, Username: session.Username}, helperIssueToken(t, cs, session.UserID), session); err != nil {
 		t.Error(err)
 		return
 	}
@@ -45,7 +52,7 @@ func TestBackend_UpdateCharacterInfo(t *testing.T) {
 	session := &bsession.Session{ID: "TEST", Conn: conn, UserID: 2137, Username: "JP", State: &bsession.SessionState{}}
 
 	// Authentication
-	if err := b.ConnectToLobby(ctx, &v1.User{UserId: session.UserID, Username: session.Username}, session); err != nil {
+	if err := b.ConnectToLobby(ctx, &v1.User{UserId: session.UserID, Username: session.Username}, helperIssueToken(t, cs, session.UserID), session); err != nil {
 		t.Error(err)
 		return
 	}
@@ -75,7 +82,7 @@ func TestBackend_UpdateCharacterInfo(t *testing.T) {
 	assert.Equal(t, int64(2137), us.UserID)
 	assert.Equal(t, int64(2137), us.User.UserID)
 	assert.Equal(t, "dev", us.User.Version)
-	assert.Equal(t, "JP", us.User.Username)
+	assert.Equal(t, "user2137", us.User.Username, "the name is read from the database, not taken from the client")
 	assert.Equal(t, int64(4), us.Character.CharacterID)
 	assert.Equal(t, byte(0x3), us.Character.ClassType)
 
@@ -86,3 +93,94 @@ func TestBackend_UpdateCharacterInfo(t *testing.T) {
 
 	time.Sleep(1 * time.Second)
 }
+
+func TestBackend_ReconnectLobby(t *testing.T) {
+	db, err := database.NewMemory()
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	// Both consoles sign the tokens with the same, configured secret.
+	sessions := auth.NewSessionSigner([]byte("persistent secret"), time.Hour, time.Now)
+	newConsole := func() *console.Console {
+		mp := console.NewMultiplayer()
+		mp.Channels = console.NewChannelList(db)
+		mp.RoomStore = console.NewRoomStore(db, time.Now)
+		return &console.Console{Multiplayer: mp, Sessions: sessions, DB: db, Bans: console.NewBanList(db, time.Now)}
+	}
+
+	// The lobby is served by the current console, the connections to the
+	// previous one are closed on restart.
+	var current atomic.Pointer[console.Console]
+	var (
+		connsMutex sync.Mutex
+		conns      []net.Conn
+	)
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current.Load().HandleWebSocket(w, r)
+	}))
+	ts.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateHijacked {
+			connsMutex.Lock()
+			conns = append(conns, conn)
+			connsMutex.Unlock()
+		}
+	}
+	ts.Start()
+	t.Cleanup(ts.Close)
+
+	before := newConsole()
+	current.Store(before)
+
+	b := &Backend{
+		SignalServerURL: "ws://" + ts.URL[len("http://"):],
+		CreateProxy:     &direct.ProxyLAN{"198.51.100.1"},
+		Reconnect:       ReconnectPolicy{MinDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond},
+	}
+	session := &bsession.Session{ID: "TEST", Conn: &mockConn{}, UserID: 2137, Username: "JP", State: &bsession.SessionState{}}
+	if err := b.ConnectToLobby(t.Context(), &v1.User{UserId: session.UserID, Username: session.Username}, helperIssueToken(t, before, session.UserID), session); err != nil {
+		t.Fatal(err)
+	}
+	session.CharacterID = 4
+	session.Proxy = &direct.LAN{}
+	if err := session.JoinLobby(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.RegisterNewObserver(t.Context(), session); err != nil {
+		t.Fatal(err)
+	}
+	defer session.Stop()
+
+	assert.Eventually(t, func() bool {
+		_, ok := before.Multiplayer.GetUserSession(session.UserID)
+		return ok
+	}, time.Second, 10*time.Millisecond)
+	if _, err := before.Multiplayer.CreateRoom(session.UserID, "room", "", v1.GameMap_AbandonedRealm, "10.0.0.1", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// Restart the console.
+	before.Multiplayer.Stop()
+	after := newConsole()
+	restored, err := after.Multiplayer.RestoreRooms(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, restored)
+	current.Store(after)
+
+	connsMutex.Lock()
+	for _, conn := range conns {
+		_ = conn.Close()
+	}
+	connsMutex.Unlock()
+
+	// The backend reconnects and the host adopts the restored room.
+	assert.Eventually(t, func() bool {
+		us, ok := after.Multiplayer.GetUserSession(session.UserID)
+		if !ok {
+			return false
+		}
+		room, ok := after.Multiplayer.GetRoom("room")
+		return ok && room.HostPlayer == us && us.GameID == "room"
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,181 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/app/logger/logging"
+	"github.com/dimspell/gladiator/internal/backend/bsession"
+	"github.com/dimspell/gladiator/internal/backend/packet"
+	"github.com/dimspell/gladiator/internal/wire"
+)
+
+// DrainPolicy describes how the players are warned before the backend shuts
+// down and how long their characters are given to be saved.
+type DrainPolicy struct {
+	// Countdown is the time between the first warning and disconnecting the
+	// players. The players are disconnected right away, when it is zero.
+	Countdown time.Duration
+
+	// Interval is the time between the countdown messages.
+	Interval time.Duration
+
+	// SaveTimeout limits how long the backend waits for the character
+	// updates, which have not reached the console yet.
+	SaveTimeout time.Duration
+
+	// Reason is appended to the countdown messages.
+	Reason string
+}
+
+var DefaultDrainPolicy = DrainPolicy{
+	Interval:    10 * time.Second,
+	SaveTimeout: 10 * time.Second,
+}
+
+// Shutdown drains the backend using the configured drain policy.
+func (b *Backend) Shutdown() {
+	b.ShutdownWith(context.Background(), b.Drain)
+}
+
+// ShutdownWith stops accepting new connections, counts down to the shutdown,
+// waits for the character updates in flight and closes all sessions. The
+// countdown is cut short when the context is cancelled. The backend is shut
+// down only once, the other calls wait until it is done.
+func (b *Backend) ShutdownWith(ctx context.Context, policy DrainPolicy) {
+	b.shutdown.Do(func() {
+		slog.Info("Shutting down the backend...", "countdown", policy.Countdown)
+
+		if b.listener != nil {
+			if err := b.listener.Close(); err != nil {
+				slog.Warn("Could not close listener", logging.Error(err))
+			}
+			b.listener = nil
+		}
+
+		b.countdown(ctx, policy)
+
+		saveCtx, cancel := context.WithTimeout(context.Background(), policy.SaveTimeout)
+		defer cancel()
+		if err := b.saves.Wait(saveCtx); err != nil {
+			slog.Warn("Not all characters have been saved", "pending", b.saves.Pending(), logging.Error(err))
+		}
+
+		// TODO: Send a packet to close the connection (malformed 255-21?)
+		b.ConnectedSessions.Range(func(k, v any) bool {
+			session := v.(*bsession.Session)
+			if err := session.Conn.Close(); err != nil {
+				slog.Error("Could not close session", logging.Error(err), "session", session.ID)
+			}
+			return true
+		})
+
+		slog.Info("The backend has successfully shut down")
+	})
+}
+
+// countdown warns the players about the shutdown until the countdown ends.
+func (b *Backend) countdown(ctx context.Context, policy DrainPolicy) {
+	if policy.Countdown <= 0 {
+		b.broadcastSystemMessage(withReason("The server is going to shut down...", policy.Reason))
+		return
+	}
+
+	for remaining := policy.Countdown; remaining > 0; {
+		text := fmt.Sprintf("The server is going to shut down in %d seconds, finish your game to save the character", int(remaining.Round(time.Second).Seconds()))
+		b.broadcastSystemMessage(withReason(text, policy.Reason))
+
+		step := remaining
+		if policy.Interval > 0 {
+			step = min(policy.Interval, remaining)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(step):
+		}
+		remaining -= step
+	}
+}
+
+func withReason(text, reason string) string {
+	if reason == "" {
+		return text
+	}
+	return text + ": " + reason
+}
+
+// broadcastSystemMessage shows the message to all connected players, both in
+// the lobby and in the game.
+func (b *Backend) broadcastSystemMessage(text string) {
+	b.ConnectedSessions.Range(func(k, v any) bool {
+		session := v.(*bsession.Session)
+		if err := session.SendToGame(packet.ReceiveMessage, NewGlobalMessage("system-info", text)); err != nil {
+			slog.Warn("Could not send the shutdown message", logging.Error(err), "session", session.ID)
+		}
+		return true
+	})
+}
+
+// drainRequested starts the shutdown requested by the console. It does not
+// block, because the shutdown closes the session, which has received the
+// request.
+func (b *Backend) drainRequested(notice wire.Drain) {
+	policy := b.Drain
+	policy.Countdown = notice.Countdown
+	policy.Reason = notice.Reason
+	go b.ShutdownWith(context.Background(), policy)
+}
+
+// inflight counts the character updates, which have not been confirmed by
+// the console yet.
+type inflight struct {
+	mu    sync.Mutex
+	count int
+	idle  chan struct{}
+}
+
+func (f *inflight) Add() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.count == 0 {
+		f.idle = make(chan struct{})
+	}
+	f.count++
+}
+
+func (f *inflight) Done() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.count--
+	if f.count == 0 {
+		close(f.idle)
+	}
+}
+
+func (f *inflight) Pending() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.count
+}
+
+// Wait blocks until there are no updates in flight or the context is done.
+func (f *inflight) Wait(ctx context.Context) error {
+	f.mu.Lock()
+	if f.count == 0 {
+		f.mu.Unlock()
+		return nil
+	}
+	idle := f.idle
+	f.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,127 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/backend/bsession"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+type closingConn struct {
+	mockConn
+	closed bool
+}
+
+func (c *closingConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestBackend_ShutdownWith(t *testing.T) {
+	newBackend := func(t *testing.T) (*Backend, *closingConn) {
+		t.Helper()
+		listener, err := net.Listen("tcp4", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		bd := &Backend{listener: listener}
+		conn := &closingConn{}
+		bd.ConnectedSessions.Store("TEST", &bsession.Session{ID: "TEST", Conn: conn})
+		return bd, conn
+	}
+
+	t.Run("counts down and closes the sessions", func(t *testing.T) {
+		bd, conn := newBackend(t)
+
+		bd.ShutdownWith(t.Context(), DrainPolicy{
+			Countdown: 30 * time.Millisecond,
+			Interval:  10 * time.Millisecond,
+			Reason:    "update",
+		})
+
+		assert.Nil(t, bd.listener)
+		assert.Equal(t, 3, bytes.Count(conn.Written, []byte("The server is going to shut down in 0 seconds")))
+		assert.Equal(t, 3, bytes.Count(conn.Written, []byte(": update")))
+		assert.True(t, conn.closed)
+
+		// Listen returns, because the backend no longer accepts connections.
+		bd.Listen()
+	})
+
+	t.Run("without countdown", func(t *testing.T) {
+		bd, conn := newBackend(t)
+
+		bd.ShutdownWith(t.Context(), DrainPolicy{})
+
+		assert.Equal(t, 1, bytes.Count(conn.Written, []byte("The server is going to shut down...")))
+		assert.True(t, conn.closed)
+	})
+
+	t.Run("cancelled countdown", func(t *testing.T) {
+		bd, conn := newBackend(t)
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		bd.ShutdownWith(ctx, DrainPolicy{Countdown: time.Hour, Interval: time.Minute})
+
+		assert.Equal(t, 1, bytes.Count(conn.Written, []byte("The server is going to shut down in 3600 seconds")))
+		assert.True(t, conn.closed)
+	})
+
+	t.Run("waits for the characters to be saved", func(t *testing.T) {
+		bd, conn := newBackend(t)
+
+		var saved atomic.Bool
+		bd.saves.Add()
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			saved.Store(true)
+			bd.saves.Done()
+		}()
+
+		bd.ShutdownWith(t.Context(), DrainPolicy{SaveTimeout: time.Second})
+
+		assert.True(t, saved.Load())
+		assert.True(t, conn.closed)
+	})
+
+	t.Run("gives up waiting for the saves", func(t *testing.T) {
+		bd, conn := newBackend(t)
+		bd.saves.Add()
+
+		bd.ShutdownWith(t.Context(), DrainPolicy{SaveTimeout: 10 * time.Millisecond})
+
+		assert.Equal(t, 1, bd.saves.Pending())
+		assert.True(t, conn.closed)
+	})
+
+	t.Run("shuts down only once", func(t *testing.T) {
+		bd, conn := newBackend(t)
+
+		bd.ShutdownWith(t.Context(), DrainPolicy{})
+		bd.ShutdownWith(t.Context(), DrainPolicy{})
+
+		assert.Equal(t, 1, bytes.Count(conn.Written, []byte("The server is going to shut down...")))
+	})
+}
+
+func TestLobbyEventHandler_DrainBackend(t *testing.T) {
+	var notices []wire.Drain
+	h := NewLobbyEventHandler(&bsession.Session{ID: "TEST", Conn: &mockConn{}})
+	h.Drain = func(notice wire.Drain) {
+		notices = append(notices, notice)
+	}
+
+	payload := wire.ComposeTyped(wire.DrainBackend, wire.MessageContent[wire.Drain]{
+		Type:    wire.DrainBackend,
+		Content: wire.Drain{Countdown: 30 * time.Second, Reason: "update"},
+	})
+	assert.NoError(t, h.Handle(t.Context(), payload))
+	assert.Equal(t, []wire.Drain{{Countdown: 30 * time.Second, Reason: "update"}}, notices)
+}
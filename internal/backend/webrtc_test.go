@@ -8,9 +8,9 @@ import (
 	"testing"
 	"time"
 
-	"connectrpc.com/connect"
 	v1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger"
+	"github.com/dimspell/gladiator/internal/backend/bsession"
 	"github.com/dimspell/gladiator/internal/backend/proxy"
 	"github.com/dimspell/gladiator/internal/backend/proxy/p2p"
 	"github.com/dimspell/gladiator/internal/console"
@@ -42,10 +42,7 @@ func TestWebRTC(t *testing.T) {
 	defer cancel()
 
 	// Create console instance and serve the HTTP
-	cs := &console.Console{
-		Multiplayer: console.NewMultiplayer(),
-		DB:          db,
-	}
+	cs := console.NewConsole(db)
 	ts := httptest.NewServer(cs.HttpRouter())
 	defer ts.Close()
 
@@ -78,7 +75,7 @@ func TestWebRTC(t *testing.T) {
 	// session1.IpRing.UdpPortPrefix = 1300
 	// session1.IpRing.TcpPortPrefix = 1400
 
-	if err := bd1.ConnectToLobby(ctx, &v1.User{UserId: 1, Username: "user1"}, session1); err != nil {
+	if err := bd1.ConnectToLobby(ctx, &v1.User{UserId: 1, Username: "user1"}, helperIssueToken(t, cs, 1), session1); err != nil {
 		t.Fatalf("failed to connect to lobby: %v", err)
 		return
 	}
@@ -97,7 +94,7 @@ func TestWebRTC(t *testing.T) {
 		t.Fatalf("failed to create room: %v", err)
 		return
 	}
-	if _, err := bd1.gameClient.CreateGame(ctx, connect.NewRequest(&v1.CreateGameRequest{
+	if _, err := bd1.gameClient.CreateGame(ctx, bsession.NewRequest(session1, &v1.CreateGameRequest{
 		GameName:      roomId,
 		MapId:         v1.GameMap_AbandonedRealm,
 		HostUserId:    1,
@@ -130,7 +127,7 @@ func TestWebRTC(t *testing.T) {
 	// session2.IpRing.UdpPortPrefix = 2300
 	// session2.IpRing.TcpPortPrefix = 2400
 
-	if err := bd2.ConnectToLobby(ctx, &v1.User{UserId: 2, Username: "user2"}, session2); err != nil {
+	if err := bd2.ConnectToLobby(ctx, &v1.User{UserId: 2, Username: "user2"}, helperIssueToken(t, cs, 2), session2); err != nil {
 		t.Fatalf("failed to connect to lobby: %v", err)
 		return
 	}
@@ -0,0 +1,479 @@
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/netip"
+	"time"
+
+	"connectrpc.com/connect"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
+	"github.com/dimspell/gladiator/internal/app/logger/logging"
+	"github.com/dimspell/gladiator/internal/console/database"
+)
+
+var _ multiv1connect.AdminServiceHandler = (*adminServiceServer)(nil)
+
+// adminServiceServer is used to moderate the running console. The calls must
+// be authorized with the role required by the procedure, see
+// NewAdminInterceptor.
+type adminServiceServer struct {
+	DB            *database.SQLite
+	Multiplayer   *Multiplayer
+	Bans          *BanList
+	Announcements *AnnouncementList
+}
+
+// ListSessions returns all users connected to the lobby.
+func (s *adminServiceServer) ListSessions(_ context.Context, _ *connect.Request[multiv1.ListSessionsRequest]) (*connect.Response[multiv1.ListSessionsResponse], error) {
+	sessions := s.Multiplayer.ListSessions()
+
+	resp := &multiv1.ListSessionsResponse{
+		Sessions: make([]*multiv1.LobbySession, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &multiv1.LobbySession{
+			UserId:      session.UserID,
+			Username:    session.User.Username,
+			CharacterId: session.Character.CharacterID,
+			ClassType:   multiv1.ClassType(session.Character.ClassType),
+			GameRoomId:  session.GameID,
+			IpAddress:   session.IPAddress,
+			ConnectedAt: session.ConnectedAt.Unix(),
+			RemoteIp:    session.RemoteIP,
+			Role:        roleToProto(session.User.Role),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListRooms returns all game rooms together with the players.
+func (s *adminServiceServer) ListRooms(_ context.Context, _ *connect.Request[multiv1.ListRoomsRequest]) (*connect.Response[multiv1.ListRoomsResponse], error) {
+	rooms := s.Multiplayer.ListRooms()
+
+	resp := &multiv1.ListRoomsResponse{
+		Rooms: make([]*multiv1.AdminRoom, 0, len(rooms)),
+	}
+	for _, room := range rooms {
+		players := make([]*multiv1.Player, 0, len(room.Players))
+		for _, player := range room.Players {
+			players = append(players, &multiv1.Player{
+				UserId:      player.UserID,
+				Username:    player.User.Username,
+				CharacterId: player.Character.CharacterID,
+				ClassType:   multiv1.ClassType(player.Character.ClassType),
+				IpAddress:   player.IPAddress,
+			})
+		}
+		hostUserID, hostIPAddress := room.HostAddress()
+		resp.Rooms = append(resp.Rooms, &multiv1.AdminRoom{
+			Game: &multiv1.Game{
+				GameId:        room.ID,
+				Name:          room.Name,
+				MapId:         room.MapID,
+				HostUserId:    hostUserID,
+				HostIpAddress: hostIPAddress,
+				PlayerCount:   int32(len(room.Players)),
+				MaxPlayers:    int32(room.MaxPlayers),
+				HasPassword:   room.HasPassword(),
+				State:         room.State,
+			},
+			Ready:   room.State != multiv1.GameState_GameStateCreating,
+			Players: players,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// DestroyRoom closes the game room and disconnects its players from the
+// relay server.
+func (s *adminServiceServer) DestroyRoom(_ context.Context, req *connect.Request[multiv1.DestroyRoomRequest]) (*connect.Response[multiv1.DestroyRoomResponse], error) {
+	if err := s.Multiplayer.CloseRoom(req.Msg.GameRoomId); err != nil {
+		if errors.Is(err, ErrRoomNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.Info("Admin destroyed the room", "gameId", req.Msg.GameRoomId)
+	return connect.NewResponse(&multiv1.DestroyRoomResponse{}), nil
+}
+
+// KickUser closes the lobby and relay connections of the user.
+func (s *adminServiceServer) KickUser(_ context.Context, req *connect.Request[multiv1.KickUserRequest]) (*connect.Response[multiv1.KickUserResponse], error) {
+	if err := s.Multiplayer.KickUser(req.Msg.UserId, req.Msg.Reason); err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.Info("Admin kicked the user", "userId", req.Msg.UserId, "reason", req.Msg.Reason)
+	return connect.NewResponse(&multiv1.KickUserResponse{}), nil
+}
+
+// BroadcastMessage sends a system message to all users in the lobby.
+func (s *adminServiceServer) BroadcastMessage(ctx context.Context, req *connect.Request[multiv1.BroadcastMessageRequest]) (*connect.Response[multiv1.BroadcastMessageResponse], error) {
+	if req.Msg.Text == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("message cannot be empty"))
+	}
+
+	recipients := s.Multiplayer.BroadcastSystemMessage(ctx, req.Msg.Text)
+	return connect.NewResponse(&multiv1.BroadcastMessageResponse{
+		Recipients: int64(recipients),
+	}), nil
+}
+
+// DrainBackends asks all connected backends to count down, save the
+// characters of their players and shut down.
+func (s *adminServiceServer) DrainBackends(ctx context.Context, req *connect.Request[multiv1.DrainBackendsRequest]) (*connect.Response[multiv1.DrainBackendsResponse], error) {
+	if req.Msg.CountdownSeconds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("countdown cannot be negative"))
+	}
+
+	countdown := time.Duration(req.Msg.CountdownSeconds) * time.Second
+	sessions := s.Multiplayer.DrainBackends(ctx, countdown, req.Msg.Reason)
+
+	slog.Info("Admin drained the backends", "countdown", countdown, "sessions", sessions, "reason", req.Msg.Reason)
+	return connect.NewResponse(&multiv1.DrainBackendsResponse{
+		Sessions: int64(sessions),
+	}), nil
+}
+
+// SetChannelMOTD changes the message of the day of the channel.
+func (s *adminServiceServer) SetChannelMOTD(ctx context.Context, req *connect.Request[multiv1.SetChannelMOTDRequest]) (*connect.Response[multiv1.SetChannelMOTDResponse], error) {
+	if err := s.Multiplayer.Channels.SetMOTD(ctx, req.Msg.Channel, req.Msg.Motd); err != nil {
+		if errors.Is(err, ErrChannelNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.Info("Admin changed the message of the day", "channel", req.Msg.Channel)
+	return connect.NewResponse(&multiv1.SetChannelMOTDResponse{}), nil
+}
+
+// CreateAnnouncement schedules the system message broadcast to the lobby.
+func (s *adminServiceServer) CreateAnnouncement(ctx context.Context, req *connect.Request[multiv1.CreateAnnouncementRequest]) (*connect.Response[multiv1.CreateAnnouncementResponse], error) {
+	createdBy := AuthCaller(ctx)
+
+	announcement, err := s.Announcements.Create(ctx,
+		req.Msg.Text,
+		req.Msg.Channel,
+		time.Duration(req.Msg.DelaySeconds)*time.Second,
+		time.Duration(req.Msg.IntervalSeconds)*time.Second,
+		createdBy,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidAnnouncement):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, ErrChannelNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.Info("Admin scheduled an announcement", "announcementId", announcement.ID, "createdBy", createdBy)
+	return connect.NewResponse(&multiv1.CreateAnnouncementResponse{
+		Announcement: announcementToProto(announcement),
+	}), nil
+}
+
+// ListAnnouncements returns all scheduled announcements.
+func (s *adminServiceServer) ListAnnouncements(ctx context.Context, _ *connect.Request[multiv1.ListAnnouncementsRequest]) (*connect.Response[multiv1.ListAnnouncementsResponse], error) {
+	announcements, err := s.Announcements.List(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &multiv1.ListAnnouncementsResponse{
+		Announcements: make([]*multiv1.Announcement, 0, len(announcements)),
+	}
+	for _, announcement := range announcements {
+		resp.Announcements = append(resp.Announcements, announcementToProto(announcement))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteAnnouncement cancels the scheduled announcement.
+func (s *adminServiceServer) DeleteAnnouncement(ctx context.Context, req *connect.Request[multiv1.DeleteAnnouncementRequest]) (*connect.Response[multiv1.DeleteAnnouncementResponse], error) {
+	if err := s.Announcements.Delete(ctx, req.Msg.AnnouncementId); err != nil {
+		if errors.Is(err, ErrAnnouncementNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.Info("Admin deleted an announcement", "announcementId", req.Msg.AnnouncementId)
+	return connect.NewResponse(&multiv1.DeleteAnnouncementResponse{}), nil
+}
+
+// CreateBan bans the user or the range of IP addresses. The matching users are
+// disconnected immediately.
+func (s *adminServiceServer) CreateBan(ctx context.Context, req *connect.Request[multiv1.CreateBanRequest]) (*connect.Response[multiv1.CreateBanResponse], error) {
+	if req.Msg.DurationSeconds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duration cannot be negative"))
+	}
+	issuedBy := AuthCaller(ctx)
+
+	ban, err := s.Bans.Create(ctx,
+		req.Msg.UserId,
+		req.Msg.Cidr,
+		req.Msg.Reason,
+		issuedBy,
+		time.Duration(req.Msg.DurationSeconds)*time.Second,
+	)
+	if err != nil {
+		if errors.Is(err, ErrInvalidBan) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	disconnected := 0
+	for _, session := range s.Multiplayer.ListSessions() {
+		addr, _ := netip.ParseAddr(session.RemoteIP)
+		if !banMatches(ban, session.UserID, addr) {
+			continue
+		}
+		if err := s.Multiplayer.KickUser(session.UserID, "banned"); err != nil {
+			slog.Warn("Could not disconnect the banned user", "userId", session.UserID, logging.Error(err))
+			continue
+		}
+		disconnected++
+	}
+
+	slog.Info("Admin created a ban", "banId", ban.ID, "issuedBy", issuedBy, "disconnected", disconnected)
+	return connect.NewResponse(&multiv1.CreateBanResponse{
+		Ban:          banToProto(ban),
+		Disconnected: int64(disconnected),
+	}), nil
+}
+
+// ListBans returns all bans, which have not expired yet.
+func (s *adminServiceServer) ListBans(ctx context.Context, _ *connect.Request[multiv1.ListBansRequest]) (*connect.Response[multiv1.ListBansResponse], error) {
+	bans, err := s.Bans.DB.Read.ListActiveBans(ctx, sql.NullInt64{Int64: s.Bans.now().Unix(), Valid: true})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &multiv1.ListBansResponse{
+		Bans: make([]*multiv1.Ban, 0, len(bans)),
+	}
+	for _, ban := range bans {
+		resp.Bans = append(resp.Bans, banToProto(ban))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// LiftBan deletes the ban.
+func (s *adminServiceServer) LiftBan(ctx context.Context, req *connect.Request[multiv1.LiftBanRequest]) (*connect.Response[multiv1.LiftBanResponse], error) {
+	deleted, err := s.Bans.DB.Write.DeleteBan(ctx, req.Msg.BanId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("ban %d not found", req.Msg.BanId))
+	}
+
+	slog.Info("Admin lifted a ban", "banId", req.Msg.BanId)
+	return connect.NewResponse(&multiv1.LiftBanResponse{}), nil
+}
+
+// SetUserRole changes the role of the user. The role of the connected user is
+// updated immediately.
+func (s *adminServiceServer) SetUserRole(ctx context.Context, req *connect.Request[multiv1.SetUserRoleRequest]) (*connect.Response[multiv1.SetUserRoleResponse], error) {
+	role, ok := roleFromProto(req.Msg.Role)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown role: %s", req.Msg.Role))
+	}
+
+	updated, err := s.DB.Write.UpdateUserRole(ctx, database.UpdateUserRoleParams{
+		Role: string(role),
+		ID:   req.Msg.UserId,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if updated == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("user %d not found", req.Msg.UserId))
+	}
+	s.Multiplayer.SetUserRole(req.Msg.UserId, role)
+
+	slog.Info("Admin changed the role of the user", "userId", req.Msg.UserId, "role", role)
+	return connect.NewResponse(&multiv1.SetUserRoleResponse{}), nil
+}
+
+// ListChatHistory returns a page of the messages sent to the channel, starting
+// from the newest ones.
+func (s *adminServiceServer) ListChatHistory(ctx context.Context, req *connect.Request[multiv1.ListChatHistoryRequest]) (*connect.Response[multiv1.ListChatHistoryResponse], error) {
+	if s.Multiplayer.History == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("chat history is not recorded"))
+	}
+	if req.Msg.Channel == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("channel cannot be empty"))
+	}
+	pageSize := req.Msg.PageSize
+	switch {
+	case pageSize <= 0:
+		pageSize = 50
+	case pageSize > 500:
+		pageSize = 500
+	}
+
+	messages, err := s.Multiplayer.History.List(ctx, req.Msg.Channel, req.Msg.BeforeId, int(pageSize))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &multiv1.ListChatHistoryResponse{
+		Messages: make([]*multiv1.ChatHistoryMessage, 0, len(messages)),
+	}
+	for _, msg := range messages {
+		resp.Messages = append(resp.Messages, &multiv1.ChatHistoryMessage{
+			MessageId: msg.ID,
+			Channel:   msg.Channel,
+			UserId:    msg.UserID,
+			Username:  msg.Username,
+			Text:      msg.Text,
+			CreatedAt: msg.CreatedAt,
+		})
+	}
+	if int64(len(messages)) == pageSize {
+		resp.NextBeforeId = messages[len(messages)-1].ID
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// MuteUser prevents the user from chatting for the given duration. The user
+// is notified when connected to the lobby.
+func (s *adminServiceServer) MuteUser(ctx context.Context, req *connect.Request[multiv1.MuteUserRequest]) (*connect.Response[multiv1.MuteUserResponse], error) {
+	if s.Multiplayer.Moderation == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("chat moderation is disabled"))
+	}
+	if req.Msg.DurationSeconds <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duration must be positive"))
+	}
+	issuedBy := AuthCaller(ctx)
+
+	mute, err := s.Multiplayer.Moderation.Mute(ctx,
+		req.Msg.UserId,
+		time.Duration(req.Msg.DurationSeconds)*time.Second,
+		req.Msg.Reason,
+		issuedBy,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if session, found := s.Multiplayer.GetUserSession(req.Msg.UserId); found {
+		s.Multiplayer.SendSystemMessage(ctx, session, (&MuteError{Mute: mute}).Error())
+	}
+	return connect.NewResponse(&multiv1.MuteUserResponse{ExpiresAt: mute.ExpiresAt}), nil
+}
+
+// UnmuteUser allows the muted user to chat again.
+func (s *adminServiceServer) UnmuteUser(ctx context.Context, req *connect.Request[multiv1.UnmuteUserRequest]) (*connect.Response[multiv1.UnmuteUserResponse], error) {
+	if s.Multiplayer.Moderation == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("chat moderation is disabled"))
+	}
+	issuedBy := AuthCaller(ctx)
+
+	unmuted, err := s.Multiplayer.Moderation.Unmute(ctx, req.Msg.UserId, issuedBy)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !unmuted {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("user %d is not muted", req.Msg.UserId))
+	}
+	if session, found := s.Multiplayer.GetUserSession(req.Msg.UserId); found {
+		s.Multiplayer.SendSystemMessage(ctx, session, "You can chat again")
+	}
+	return connect.NewResponse(&multiv1.UnmuteUserResponse{}), nil
+}
+
+// ListModerationLog returns a page of the moderation actions, starting from
+// the newest ones.
+func (s *adminServiceServer) ListModerationLog(ctx context.Context, req *connect.Request[multiv1.ListModerationLogRequest]) (*connect.Response[multiv1.ListModerationLogResponse], error) {
+	beforeID := req.Msg.BeforeId
+	if beforeID <= 0 {
+		beforeID = math.MaxInt64
+	}
+	pageSize := req.Msg.PageSize
+	switch {
+	case pageSize <= 0:
+		pageSize = 50
+	case pageSize > 500:
+		pageSize = 500
+	}
+
+	entries, err := s.DB.Read.ListModerationLog(ctx, database.ListModerationLogParams{
+		ID:    beforeID,
+		Limit: pageSize,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &multiv1.ListModerationLogResponse{
+		Entries: make([]*multiv1.ModerationLogEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &multiv1.ModerationLogEntry{
+			EntryId:   entry.ID,
+			Action:    entry.Action,
+			UserId:    entry.UserID,
+			Channel:   entry.Channel,
+			Actor:     entry.Actor,
+			Reason:    entry.Reason,
+			CreatedAt: entry.CreatedAt,
+		})
+	}
+	if int64(len(entries)) == pageSize {
+		resp.NextBeforeId = entries[len(entries)-1].ID
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func banToProto(ban database.Ban) *multiv1.Ban {
+	return &multiv1.Ban{
+		BanId:     ban.ID,
+		UserId:    ban.UserID.Int64,
+		Cidr:      ban.Cidr.String,
+		Reason:    ban.Reason,
+		IssuedBy:  ban.IssuedBy,
+		CreatedAt: ban.CreatedAt,
+		ExpiresAt: ban.ExpiresAt.Int64,
+	}
+}
+
+func announcementToProto(announcement database.Announcement) *multiv1.Announcement {
+	return &multiv1.Announcement{
+		AnnouncementId:  announcement.ID,
+		Text:            announcement.Text,
+		Channel:         announcement.Channel,
+		IntervalSeconds: announcement.IntervalSeconds,
+		NextRunAt:       announcement.NextRunAt,
+		CreatedBy:       announcement.CreatedBy,
+		CreatedAt:       announcement.CreatedAt,
+	}
+}
+
+// NewAdminServiceClient creates a client of the AdminService, which sends the
+// token with every request. It is either the admin secret or the session token
+// of a user with the moderator or admin role.
+func NewAdminServiceClient(httpClient *http.Client, consoleAddr string, secret string) multiv1connect.AdminServiceClient {
+	bearer := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+secret)
+			return next(ctx, req)
+		}
+	})
+	return multiv1connect.NewAdminServiceClient(httpClient, fmt.Sprintf("%s/grpc", consoleAddr), connect.WithInterceptors(bearer))
+}
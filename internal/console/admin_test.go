@@ -0,0 +1,420 @@
+package console
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/coder/websocket"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingConn struct {
+	mockConn
+
+	mu          sync.Mutex
+	written     [][]byte
+	closeCode   websocket.StatusCode
+	closeReason string
+}
+
+func (c *recordingConn) Write(ctx context.Context, typ websocket.MessageType, p []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.written = append(c.written, p)
+	return nil
+}
+
+func (c *recordingConn) Close(code websocket.StatusCode, reason string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closeCode, c.closeReason = code, reason
+	return nil
+}
+
+func TestAdminService(t *testing.T) {
+	newConsole := func(t *testing.T) (*Console, *httptest.Server) {
+		t.Helper()
+
+		c := NewConsole(setupDatabase(t))
+		c.Config.AdminSecret = []byte("admin-secret-1234")
+		ts := httptest.NewServer(c.HttpRouter())
+		t.Cleanup(ts.Close)
+		return c, ts
+	}
+
+	newClient := func(t *testing.T, secret string) (multiv1connect.AdminServiceClient, *Multiplayer) {
+		t.Helper()
+
+		c, ts := newConsole(t)
+		return NewAdminServiceClient(http.DefaultClient, ts.URL, secret), c.Multiplayer
+	}
+
+	newUser := func(t *testing.T, c *Console, username string, role wire.Role) (int64, string) {
+		t.Helper()
+
+		user, err := c.DB.Write.CreateUser(t.Context(), database.CreateUserParams{Username: username, Password: "x"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.DB.Write.UpdateUserRole(t.Context(), database.UpdateUserRoleParams{Role: string(role), ID: user.ID}); err != nil {
+			t.Fatal(err)
+		}
+		token, err := c.Sessions.Issue(user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return user.ID, token
+	}
+
+	addSession := func(mp *Multiplayer, userID int64, username string) *recordingConn {
+		conn := &recordingConn{}
+		session := NewUserSession(userID, conn)
+		session.User = wire.User{UserID: userID, Username: username}
+		mp.AddUserSession(userID, session)
+		return conn
+	}
+
+	t.Run("requires admin secret", func(t *testing.T) {
+		client, _ := newClient(t, "wrong")
+		_, err := client.ListSessions(t.Context(), connect.NewRequest(&multiv1.ListSessionsRequest{}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		client, _ = newClient(t, "")
+		_, err = client.ListSessions(t.Context(), connect.NewRequest(&multiv1.ListSessionsRequest{}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("requires role of the procedure", func(t *testing.T) {
+		c, ts := newConsole(t)
+		_, playerToken := newUser(t, c, "player", wire.RolePlayer)
+		_, moderatorToken := newUser(t, c, "moderator", wire.RoleModerator)
+		_, adminToken := newUser(t, c, "admin", wire.RoleAdmin)
+
+		player := NewAdminServiceClient(http.DefaultClient, ts.URL, playerToken)
+		_, err := player.ListSessions(t.Context(), connect.NewRequest(&multiv1.ListSessionsRequest{}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		moderator := NewAdminServiceClient(http.DefaultClient, ts.URL, moderatorToken)
+		_, err = moderator.ListSessions(t.Context(), connect.NewRequest(&multiv1.ListSessionsRequest{}))
+		assert.NoError(t, err)
+		_, err = moderator.CreateBan(t.Context(), connect.NewRequest(&multiv1.CreateBanRequest{UserId: 1}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		admin := NewAdminServiceClient(http.DefaultClient, ts.URL, adminToken)
+		_, err = admin.CreateBan(t.Context(), connect.NewRequest(&multiv1.CreateBanRequest{UserId: 1}))
+		assert.NoError(t, err)
+	})
+
+	t.Run("records the caller as the author", func(t *testing.T) {
+		c, ts := newConsole(t)
+		_, token := newUser(t, c, "warden", wire.RoleAdmin)
+		client := NewAdminServiceClient(http.DefaultClient, ts.URL, token)
+
+		created, err := client.CreateBan(t.Context(), connect.NewRequest(&multiv1.CreateBanRequest{UserId: 1}))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "warden", created.Msg.Ban.IssuedBy)
+		}
+
+		_, err = client.MuteUser(t.Context(), connect.NewRequest(&multiv1.MuteUserRequest{UserId: 2, DurationSeconds: 600}))
+		assert.NoError(t, err)
+		_, err = client.UnmuteUser(t.Context(), connect.NewRequest(&multiv1.UnmuteUserRequest{UserId: 2}))
+		assert.NoError(t, err)
+		log, err := client.ListModerationLog(t.Context(), connect.NewRequest(&multiv1.ListModerationLogRequest{}))
+		if assert.NoError(t, err) && assert.Len(t, log.Msg.Entries, 2) {
+			assert.Equal(t, "warden", log.Msg.Entries[0].Actor)
+			assert.Equal(t, "warden", log.Msg.Entries[1].Actor)
+		}
+
+		announced, err := client.CreateAnnouncement(t.Context(), connect.NewRequest(&multiv1.CreateAnnouncementRequest{Text: "hello"}))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "warden", announced.Msg.Announcement.CreatedBy)
+		}
+	})
+
+	t.Run("set user role", func(t *testing.T) {
+		c, ts := newConsole(t)
+		client := NewAdminServiceClient(http.DefaultClient, ts.URL, "admin-secret-1234")
+		userID, _ := newUser(t, c, "archer", wire.RolePlayer)
+		addSession(c.Multiplayer, userID, "archer")
+
+		_, err := client.SetUserRole(t.Context(), connect.NewRequest(&multiv1.SetUserRoleRequest{UserId: userID, Role: multiv1.Role_RoleModerator}))
+		assert.NoError(t, err)
+
+		user, err := c.DB.Read.GetUserByID(t.Context(), userID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "moderator", user.Role)
+		}
+		sessions, err := client.ListSessions(t.Context(), connect.NewRequest(&multiv1.ListSessionsRequest{}))
+		if assert.NoError(t, err) && assert.Len(t, sessions.Msg.Sessions, 1) {
+			assert.Equal(t, multiv1.Role_RoleModerator, sessions.Msg.Sessions[0].Role)
+		}
+
+		_, err = client.SetUserRole(t.Context(), connect.NewRequest(&multiv1.SetUserRoleRequest{UserId: 100, Role: multiv1.Role_RoleAdmin}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		_, err = client.SetUserRole(t.Context(), connect.NewRequest(&multiv1.SetUserRoleRequest{UserId: userID, Role: multiv1.Role(10)}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("list sessions and rooms", func(t *testing.T) {
+		client, mp := newClient(t, "admin-secret-1234")
+		addSession(mp, 2, "mage")
+		addSession(mp, 1, "archer")
+		if _, err := mp.CreateRoom(1, "room1", "", multiv1.GameMap_FrozenLabyrinth, "127.0.0.1", 0); err != nil {
+			t.Fatal(err)
+		}
+
+		sessions, err := client.ListSessions(t.Context(), connect.NewRequest(&multiv1.ListSessionsRequest{}))
+		if assert.NoError(t, err) && assert.Len(t, sessions.Msg.Sessions, 2) {
+			assert.Equal(t, "archer", sessions.Msg.Sessions[0].Username)
+			assert.Equal(t, "room1", sessions.Msg.Sessions[0].GameRoomId)
+			assert.Equal(t, "mage", sessions.Msg.Sessions[1].Username)
+		}
+
+		rooms, err := client.ListRooms(t.Context(), connect.NewRequest(&multiv1.ListRoomsRequest{}))
+		if assert.NoError(t, err) && assert.Len(t, rooms.Msg.Rooms, 1) {
+			assert.Equal(t, "room1", rooms.Msg.Rooms[0].Game.GameId)
+			assert.Equal(t, int64(1), rooms.Msg.Rooms[0].Game.HostUserId)
+			assert.Len(t, rooms.Msg.Rooms[0].Players, 1)
+		}
+
+		mp.roomsMutex.Lock()
+		mp.Rooms["room1"].HostPlayer = nil
+		mp.roomsMutex.Unlock()
+		rooms, err = client.ListRooms(t.Context(), connect.NewRequest(&multiv1.ListRoomsRequest{}))
+		if assert.NoError(t, err, "the room without the host is listed") && assert.Len(t, rooms.Msg.Rooms, 1) {
+			assert.Zero(t, rooms.Msg.Rooms[0].Game.HostUserId)
+		}
+	})
+
+	t.Run("destroy room", func(t *testing.T) {
+		client, mp := newClient(t, "admin-secret-1234")
+		addSession(mp, 1, "archer")
+		if _, err := mp.CreateRoom(1, "room1", "", multiv1.GameMap_FrozenLabyrinth, "127.0.0.1", 0); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err := client.DestroyRoom(t.Context(), connect.NewRequest(&multiv1.DestroyRoomRequest{GameRoomId: "room1"}))
+		assert.NoError(t, err)
+
+		_, found := mp.GetRoom("room1")
+		assert.False(t, found)
+		session, _ := mp.GetUserSession(1)
+		assert.Empty(t, session.GameID)
+
+		_, err = client.DestroyRoom(t.Context(), connect.NewRequest(&multiv1.DestroyRoomRequest{GameRoomId: "room1"}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("kick user", func(t *testing.T) {
+		client, mp := newClient(t, "admin-secret-1234")
+		conn := addSession(mp, 1, "archer")
+
+		_, err := client.KickUser(t.Context(), connect.NewRequest(&multiv1.KickUserRequest{UserId: 1, Reason: "cheating"}))
+		assert.NoError(t, err)
+		assert.Equal(t, websocket.StatusPolicyViolation, conn.closeCode)
+		assert.Equal(t, "cheating", conn.closeReason)
+
+		_, err = client.KickUser(t.Context(), connect.NewRequest(&multiv1.KickUserRequest{UserId: 2}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("broadcast message", func(t *testing.T) {
+		client, mp := newClient(t, "admin-secret-1234")
+		conns := []*recordingConn{addSession(mp, 1, "archer"), addSession(mp, 2, "mage")}
+
+		resp, err := client.BroadcastMessage(t.Context(), connect.NewRequest(&multiv1.BroadcastMessageRequest{Text: "Server restart in 5 minutes"}))
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(2), resp.Msg.Recipients)
+		}
+
+		for _, conn := range conns {
+			if assert.Len(t, conn.written, 1) {
+				et, msg, err := wire.DecodeTyped[wire.ChatMessage](conn.written[0])
+				assert.NoError(t, err)
+				assert.Equal(t, wire.SystemMessage, et)
+				assert.Equal(t, "Server restart in 5 minutes", msg.Content.Text)
+			}
+		}
+
+		_, err = client.BroadcastMessage(t.Context(), connect.NewRequest(&multiv1.BroadcastMessageRequest{}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+	t.Run("drain backends", func(t *testing.T) {
+		client, mp := newClient(t, "admin-secret-1234")
+		conns := []*recordingConn{addSession(mp, 1, "archer"), addSession(mp, 2, "mage")}
+
+		resp, err := client.DrainBackends(t.Context(), connect.NewRequest(&multiv1.DrainBackendsRequest{CountdownSeconds: 30, Reason: "update"}))
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(2), resp.Msg.Sessions)
+		}
+
+		for _, conn := range conns {
+			if assert.Len(t, conn.written, 1) {
+				et, msg, err := wire.DecodeTyped[wire.Drain](conn.written[0])
+				assert.NoError(t, err)
+				assert.Equal(t, wire.DrainBackend, et)
+				assert.Equal(t, wire.Drain{Countdown: 30 * time.Second, Reason: "update"}, msg.Content)
+			}
+		}
+
+		_, err = client.DrainBackends(t.Context(), connect.NewRequest(&multiv1.DrainBackendsRequest{CountdownSeconds: -1}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("create, list and lift bans", func(t *testing.T) {
+		client, mp := newClient(t, "admin-secret-1234")
+		archer := addSession(mp, 1, "archer")
+		mage := addSession(mp, 2, "mage")
+		mp.sessions[2].RemoteIP = "192.0.2.7"
+
+		created, err := client.CreateBan(t.Context(), connect.NewRequest(&multiv1.CreateBanRequest{
+			Cidr:   "192.0.2.0/24",
+			Reason: "cheating",
+		}))
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(1), created.Msg.Disconnected)
+			assert.Equal(t, "192.0.2.0/24", created.Msg.Ban.Cidr)
+			assert.Equal(t, "admin", created.Msg.Ban.IssuedBy)
+		}
+		assert.Equal(t, websocket.StatusPolicyViolation, mage.closeCode)
+		assert.Zero(t, archer.closeCode)
+
+		_, err = client.CreateBan(t.Context(), connect.NewRequest(&multiv1.CreateBanRequest{
+			UserId:          1,
+			DurationSeconds: 3600,
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, websocket.StatusPolicyViolation, archer.closeCode)
+
+		_, err = client.CreateBan(t.Context(), connect.NewRequest(&multiv1.CreateBanRequest{UserId: 1, Cidr: "192.0.2.0/24"}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		list, err := client.ListBans(t.Context(), connect.NewRequest(&multiv1.ListBansRequest{}))
+		if assert.NoError(t, err) && assert.Len(t, list.Msg.Bans, 2) {
+			assert.NotZero(t, list.Msg.Bans[1].ExpiresAt)
+		}
+
+		_, err = client.LiftBan(t.Context(), connect.NewRequest(&multiv1.LiftBanRequest{BanId: created.Msg.Ban.BanId}))
+		assert.NoError(t, err)
+
+		_, err = client.LiftBan(t.Context(), connect.NewRequest(&multiv1.LiftBanRequest{BanId: created.Msg.Ban.BanId}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+	t.Run("list chat history", func(t *testing.T) {
+		client, mp := newClient(t, "admin-secret-1234")
+		for _, text := range []string{"one", "two", "three"} {
+			assert.NoError(t, mp.History.Record(t.Context(), "DISPEL", 1, wire.ChatMessage{User: "archer", Text: text}))
+		}
+
+		page, err := client.ListChatHistory(t.Context(), connect.NewRequest(&multiv1.ListChatHistoryRequest{
+			Channel:  "DISPEL",
+			PageSize: 2,
+		}))
+		if assert.NoError(t, err) && assert.Len(t, page.Msg.Messages, 2) {
+			assert.Equal(t, "three", page.Msg.Messages[0].Text)
+			assert.Equal(t, "archer", page.Msg.Messages[0].Username)
+			assert.Equal(t, page.Msg.Messages[1].MessageId, page.Msg.NextBeforeId)
+		}
+
+		page, err = client.ListChatHistory(t.Context(), connect.NewRequest(&multiv1.ListChatHistoryRequest{
+			Channel:  "DISPEL",
+			BeforeId: page.Msg.NextBeforeId,
+			PageSize: 2,
+		}))
+		if assert.NoError(t, err) && assert.Len(t, page.Msg.Messages, 1) {
+			assert.Equal(t, "one", page.Msg.Messages[0].Text)
+			assert.Zero(t, page.Msg.NextBeforeId)
+		}
+
+		_, err = client.ListChatHistory(t.Context(), connect.NewRequest(&multiv1.ListChatHistoryRequest{}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+	t.Run("mute, unmute and list moderation log", func(t *testing.T) {
+		client, mp := newClient(t, "admin-secret-1234")
+		conn := addSession(mp, 1, "archer")
+
+		muted, err := client.MuteUser(t.Context(), connect.NewRequest(&multiv1.MuteUserRequest{
+			UserId:          1,
+			DurationSeconds: 600,
+			Reason:          "spam",
+		}))
+		if assert.NoError(t, err) {
+			assert.NotZero(t, muted.Msg.ExpiresAt)
+		}
+		if assert.Len(t, conn.written, 1) {
+			_, msg, err := wire.DecodeTyped[wire.ChatMessage](conn.written[0])
+			assert.NoError(t, err)
+			assert.Contains(t, msg.Content.Text, "you are muted until")
+		}
+		assert.Error(t, mp.Moderation.CheckMute(t.Context(), 1))
+
+		_, err = client.MuteUser(t.Context(), connect.NewRequest(&multiv1.MuteUserRequest{UserId: 1}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = client.UnmuteUser(t.Context(), connect.NewRequest(&multiv1.UnmuteUserRequest{UserId: 1}))
+		assert.NoError(t, err)
+		assert.NoError(t, mp.Moderation.CheckMute(t.Context(), 1))
+
+		_, err = client.UnmuteUser(t.Context(), connect.NewRequest(&multiv1.UnmuteUserRequest{UserId: 1}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		log, err := client.ListModerationLog(t.Context(), connect.NewRequest(&multiv1.ListModerationLogRequest{}))
+		if assert.NoError(t, err) && assert.Len(t, log.Msg.Entries, 2) {
+			assert.Equal(t, ModerationUnmute, log.Msg.Entries[0].Action)
+			assert.Equal(t, ModerationMute, log.Msg.Entries[1].Action)
+			assert.Equal(t, "admin", log.Msg.Entries[1].Actor)
+			assert.Equal(t, "spam", log.Msg.Entries[1].Reason)
+			assert.Zero(t, log.Msg.NextBeforeId)
+		}
+	})
+
+	t.Run("channel message of the day", func(t *testing.T) {
+		client, mp := newClient(t, "admin-secret-1234")
+
+		_, err := client.SetChannelMOTD(t.Context(), connect.NewRequest(&multiv1.SetChannelMOTDRequest{Channel: "DISPEL", Motd: "Welcome"}))
+		assert.NoError(t, err)
+		assert.Equal(t, "Welcome", mp.ChannelMOTD(t.Context(), "DISPEL"))
+
+		_, err = client.SetChannelMOTD(t.Context(), connect.NewRequest(&multiv1.SetChannelMOTDRequest{Channel: "UNKNOWN", Motd: "Welcome"}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("create, list and delete announcements", func(t *testing.T) {
+		client, _ := newClient(t, "admin-secret-1234")
+
+		created, err := client.CreateAnnouncement(t.Context(), connect.NewRequest(&multiv1.CreateAnnouncementRequest{
+			Text:            "Visit our forum",
+			IntervalSeconds: 3600,
+		}))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "admin", created.Msg.Announcement.CreatedBy)
+			assert.Equal(t, int64(3600), created.Msg.Announcement.IntervalSeconds)
+		}
+
+		_, err = client.CreateAnnouncement(t.Context(), connect.NewRequest(&multiv1.CreateAnnouncementRequest{}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		_, err = client.CreateAnnouncement(t.Context(), connect.NewRequest(&multiv1.CreateAnnouncementRequest{Text: "hello", Channel: "UNKNOWN"}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		list, err := client.ListAnnouncements(t.Context(), connect.NewRequest(&multiv1.ListAnnouncementsRequest{}))
+		if assert.NoError(t, err) && assert.Len(t, list.Msg.Announcements, 1) {
+			assert.Equal(t, "Visit our forum", list.Msg.Announcements[0].Text)
+		}
+
+		id := created.Msg.Announcement.AnnouncementId
+		_, err = client.DeleteAnnouncement(t.Context(), connect.NewRequest(&multiv1.DeleteAnnouncementRequest{AnnouncementId: id}))
+		assert.NoError(t, err)
+		_, err = client.DeleteAnnouncement(t.Context(), connect.NewRequest(&multiv1.DeleteAnnouncementRequest{AnnouncementId: id}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
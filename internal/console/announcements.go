@@ -0,0 +1,143 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/app/logger/logging"
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+)
+
+var (
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+	ErrInvalidAnnouncement  = errors.New("invalid announcement")
+)
+
+// AnnouncementList stores the announcements broadcast by the console as system
+// messages. An announcement is sent once at the scheduled time, or repeated
+// with the interval. The announcements without a channel are sent to all
+// users in the lobby.
+type AnnouncementList struct {
+	DB          *database.SQLite
+	Multiplayer *Multiplayer
+
+	// Tick is how often the due announcements are looked up.
+	Tick time.Duration
+}
+
+func NewAnnouncementList(db *database.SQLite, mp *Multiplayer) *AnnouncementList {
+	return &AnnouncementList{DB: db, Multiplayer: mp, Tick: 5 * time.Second}
+}
+
+// Create schedules the announcement. The first one is sent after the delay,
+// the next ones every interval. The announcement is sent only once, when the
+// interval is zero.
+func (a *AnnouncementList) Create(ctx context.Context, text, channel string, delay, interval time.Duration, createdBy string) (database.Announcement, error) {
+	if text == "" {
+		return database.Announcement{}, fmt.Errorf("%w: text cannot be empty", ErrInvalidAnnouncement)
+	}
+	if delay < 0 || interval < 0 {
+		return database.Announcement{}, fmt.Errorf("%w: delay and interval cannot be negative", ErrInvalidAnnouncement)
+	}
+	if interval > 0 && interval < time.Minute {
+		return database.Announcement{}, fmt.Errorf("%w: interval must be at least a minute", ErrInvalidAnnouncement)
+	}
+	if channel != "" && a.Multiplayer.Channels != nil {
+		if _, err := a.Multiplayer.Channels.Get(ctx, channel); err != nil {
+			return database.Announcement{}, err
+		}
+	}
+
+	now := a.Multiplayer.now()
+	return a.DB.Write.CreateAnnouncement(ctx, database.CreateAnnouncementParams{
+		Text:            text,
+		Channel:         channel,
+		IntervalSeconds: int64(interval.Seconds()),
+		NextRunAt:       now.Add(delay).Unix(),
+		CreatedBy:       createdBy,
+		CreatedAt:       now.Unix(),
+	})
+}
+
+// List returns all scheduled announcements.
+func (a *AnnouncementList) List(ctx context.Context) ([]database.Announcement, error) {
+	return a.DB.Read.ListAnnouncements(ctx)
+}
+
+// Delete cancels the announcement.
+func (a *AnnouncementList) Delete(ctx context.Context, id int64) error {
+	deleted, err := a.DB.Write.DeleteAnnouncement(ctx, id)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrAnnouncementNotFound
+	}
+	return nil
+}
+
+// Run sends the due announcements until the context is done.
+func (a *AnnouncementList) Run(ctx context.Context) {
+	if a == nil {
+		return
+	}
+	ticker := time.NewTicker(a.Tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.SendDue(ctx); err != nil {
+				slog.Warn("Could not send the announcements", logging.Error(err))
+			}
+		}
+	}
+}
+
+// SendDue broadcasts the announcements, which time has come, and schedules
+// their next run. The announcements sent only once are removed.
+func (a *AnnouncementList) SendDue(ctx context.Context) error {
+	now := a.Multiplayer.now().Unix()
+	due, err := a.DB.Read.ListDueAnnouncements(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, announcement := range due {
+		payload := wire.ComposeTyped(wire.SystemMessage, wire.MessageContent[wire.ChatMessage]{
+			Type:    wire.SystemMessage,
+			Content: wire.ChatMessage{User: "System", Text: announcement.Text},
+		})
+		if announcement.Channel == "" {
+			a.Multiplayer.BroadcastMessage(ctx, payload)
+		} else {
+			a.Multiplayer.BroadcastChannelMessage(ctx, announcement.Channel, payload)
+		}
+
+		if announcement.IntervalSeconds == 0 {
+			if _, err := a.DB.Write.DeleteAnnouncement(ctx, announcement.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Skip the runs missed while the console was not running.
+		next := announcement.NextRunAt + announcement.IntervalSeconds
+		if next <= now {
+			next = now + announcement.IntervalSeconds
+		}
+		if err := a.DB.Write.UpdateAnnouncementNextRun(ctx, database.UpdateAnnouncementNextRunParams{
+			NextRunAt: next,
+			ID:        announcement.ID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnouncementList(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	setup := func(t *testing.T) (*AnnouncementList, *recordingConn) {
+		t.Helper()
+		db := setupDatabase(t)
+		mp := NewMultiplayer()
+		mp.Channels = NewChannelList(db)
+
+		conn := &recordingConn{}
+		session := NewUserSession(1, conn)
+		session.User = wire.User{UserID: 1, Username: "archer"}
+		session.Channel = "DISPEL"
+		mp.AddUserSession(1, session)
+
+		mp.now = func() time.Time { return now }
+		a := NewAnnouncementList(db, mp)
+		return a, conn
+	}
+	received := func(t *testing.T, conn *recordingConn) []string {
+		t.Helper()
+		var texts []string
+		for _, payload := range conn.written {
+			et, msg, err := wire.DecodeTyped[wire.ChatMessage](payload)
+			assert.NoError(t, err)
+			assert.Equal(t, wire.SystemMessage, et)
+			texts = append(texts, msg.Content.Text)
+		}
+		return texts
+	}
+
+	t.Run("sends the announcement once", func(t *testing.T) {
+		a, conn := setup(t)
+		_, err := a.Create(t.Context(), "Tournament tonight", "", time.Minute, 0, "admin")
+		assert.NoError(t, err)
+
+		assert.NoError(t, a.SendDue(t.Context()))
+		assert.Empty(t, conn.written, "the announcement is not due yet")
+
+		now = now.Add(time.Minute)
+		assert.NoError(t, a.SendDue(t.Context()))
+		assert.NoError(t, a.SendDue(t.Context()))
+		assert.Equal(t, []string{"Tournament tonight"}, received(t, conn))
+
+		list, err := a.List(t.Context())
+		assert.NoError(t, err)
+		assert.Empty(t, list)
+	})
+
+	t.Run("repeats the announcement", func(t *testing.T) {
+		a, conn := setup(t)
+		created, err := a.Create(t.Context(), "Visit our forum", "DISPEL", 0, time.Hour, "admin")
+		assert.NoError(t, err)
+
+		assert.NoError(t, a.SendDue(t.Context()))
+		now = now.Add(30 * time.Minute)
+		assert.NoError(t, a.SendDue(t.Context()))
+		assert.Equal(t, []string{"Visit our forum"}, received(t, conn))
+
+		// The missed runs are skipped.
+		now = now.Add(5 * time.Hour)
+		assert.NoError(t, a.SendDue(t.Context()))
+		list, err := a.List(t.Context())
+		assert.NoError(t, err)
+		if assert.Len(t, list, 1) {
+			assert.Equal(t, now.Add(time.Hour).Unix(), list[0].NextRunAt)
+		}
+		assert.Len(t, received(t, conn), 2)
+
+		assert.NoError(t, a.Delete(t.Context(), created.ID))
+		assert.ErrorIs(t, a.Delete(t.Context(), created.ID), ErrAnnouncementNotFound)
+	})
+
+	t.Run("other channels do not receive the announcement", func(t *testing.T) {
+		a, conn := setup(t)
+		_, err := a.DB.Write.CreateChannel(t.Context(), database.CreateChannelParams{Name: "VETERANS"})
+		assert.NoError(t, err)
+		_, err = a.Create(t.Context(), "Veterans only", "VETERANS", 0, 0, "admin")
+		assert.NoError(t, err)
+
+		assert.NoError(t, a.SendDue(t.Context()))
+		assert.Empty(t, conn.written)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		a, _ := setup(t)
+		_, err := a.Create(t.Context(), "", "", 0, 0, "admin")
+		assert.ErrorIs(t, err, ErrInvalidAnnouncement)
+		_, err = a.Create(t.Context(), "hello", "", -time.Second, 0, "admin")
+		assert.ErrorIs(t, err, ErrInvalidAnnouncement)
+		_, err = a.Create(t.Context(), "hello", "", 0, time.Second, "admin")
+		assert.ErrorIs(t, err, ErrInvalidAnnouncement)
+		_, err = a.Create(t.Context(), "hello", "UNKNOWN", 0, 0, "admin")
+		assert.ErrorIs(t, err, ErrChannelNotFound)
+	})
+}
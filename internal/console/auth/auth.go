@@ -1,18 +1,32 @@
 package auth
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
 	"log/slog"
 
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// DefaultPasswordCost is the bcrypt cost used to hash the passwords, unless
+// the console is configured otherwise.
+const DefaultPasswordCost = 14
+
 type Password []byte
 
 // NewPassword creates a new password from a plain text string.
 func NewPassword(text string) (Password, error) {
-	// TODO: Use salt and pepper
-	pwd, err := bcrypt.GenerateFromPassword([]byte(text), 14)
+	return NewPasswordWithCost(text, DefaultPasswordCost)
+}
+
+// NewPasswordWithCost creates a new password hashed with the given bcrypt
+// cost. The salt is generated by bcrypt and stored as a part of the hash.
+func NewPasswordWithCost(text string, cost int) (Password, error) {
+	pwd, err := bcrypt.GenerateFromPassword([]byte(text), cost)
 	if err != nil {
 		slog.Warn("Could not hash password", logging.Error(err))
 	}
@@ -29,3 +43,31 @@ func CheckPassword(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+// NeedsRehash reports whether the hash has been generated with a different
+// cost than the given one.
+func NeedsRehash(hash string, cost int) bool {
+	hashCost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || hashCost != cost
+}
+
+// NewResetCode generates a one-time code used to reset the forgotten
+// password. Only the hash of the code should be stored.
+func NewResetCode() (code string, hash string, err error) {
+	buf := make([]byte, 10)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	code = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf)
+	return code, hashResetCode(code), nil
+}
+
+// CheckResetCode checks if the code matches the stored hash.
+func CheckResetCode(code, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashResetCode(code)), []byte(hash)) == 1
+}
+
+func hashResetCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPassword(t *testing.T) {
+	pwd, err := NewPasswordWithCost("secret", bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	assert.True(t, CheckPassword("secret", pwd.String()))
+	assert.False(t, CheckPassword("other", pwd.String()))
+
+	assert.False(t, NeedsRehash(pwd.String(), bcrypt.MinCost))
+	assert.True(t, NeedsRehash(pwd.String(), bcrypt.MinCost+1))
+	assert.True(t, NeedsRehash("not a hash", bcrypt.MinCost))
+}
+
+func TestResetCode(t *testing.T) {
+	code, hash, err := NewResetCode()
+	assert.NoError(t, err)
+	assert.Len(t, code, 16)
+
+	assert.True(t, CheckResetCode(code, hash))
+	assert.False(t, CheckResetCode(code+"A", hash))
+
+	other, _, err := NewResetCode()
+	assert.NoError(t, err)
+	assert.NotEqual(t, code, other)
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid session token")
+	ErrExpiredToken = errors.New("session token has expired")
+)
+
+// SessionSigner issues and verifies bearer tokens handed out to the users
+// after a successful sign-in. The token is a base64-encoded payload followed
+// by its HMAC-SHA256 signature, separated with a dot.
+type SessionSigner struct {
+	key []byte
+	ttl time.Duration
+	now func() time.Time
+}
+
+type sessionClaims struct {
+	UserID    int64 `json:"uid"`
+	ExpiresAt int64 `json:"exp"`
+}
+
+// NewSessionSigner creates the signer of the tokens valid for the ttl. The
+// expiry is measured with the given clock, usually time.Now.
+func NewSessionSigner(key []byte, ttl time.Duration, now func() time.Time) *SessionSigner {
+	return &SessionSigner{
+		key: key,
+		ttl: ttl,
+		now: now,
+	}
+}
+
+// Issue creates a new session token for the given user.
+func (s *SessionSigner) Issue(userID int64) (string, error) {
+	payload, err := json.Marshal(sessionClaims{
+		UserID:    userID,
+		ExpiresAt: s.now().Add(s.ttl).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded)), nil
+}
+
+// Verify checks the signature and the expiry of the token and returns the ID
+// of the user it was issued for.
+func (s *SessionSigner) Verify(token string) (int64, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, ErrInvalidToken
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.sign(encoded)) {
+		return 0, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+	var claims sessionClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if claims.UserID == 0 {
+		return 0, ErrInvalidToken
+	}
+	if s.now().Unix() >= claims.ExpiresAt {
+		return 0, ErrExpiredToken
+	}
+
+	return claims.UserID, nil
+}
+
+// RelayKey derives the key used to sign the packets exchanged with the relay
+// server during the session identified by the token.
+func (s *SessionSigner) RelayKey(token string) []byte {
+	return s.sign("relay:" + token)
+}
+
+func (s *SessionSigner) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
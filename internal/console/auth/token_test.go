@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionSigner(t *testing.T) {
+	t.Run("issue and verify", func(t *testing.T) {
+		signer := NewSessionSigner([]byte("secret"), time.Hour, time.Now)
+
+		token, err := signer.Issue(10)
+		assert.NoError(t, err)
+
+		userID, err := signer.Verify(token)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), userID)
+	})
+
+	t.Run("different key", func(t *testing.T) {
+		token, err := NewSessionSigner([]byte("secret"), time.Hour, time.Now).Issue(10)
+		assert.NoError(t, err)
+
+		_, err = NewSessionSigner([]byte("other"), time.Hour, time.Now).Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		signer := NewSessionSigner([]byte("secret"), time.Hour, time.Now)
+		token, err := signer.Issue(10)
+		assert.NoError(t, err)
+
+		_, err = signer.Verify("x" + token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+
+		_, err = signer.Verify("garbage")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("relay key", func(t *testing.T) {
+		signer := NewSessionSigner([]byte("secret"), time.Hour, time.Now)
+		token1, _ := signer.Issue(10)
+		token2, _ := signer.Issue(11)
+
+		assert.Len(t, signer.RelayKey(token1), 32)
+		assert.Equal(t, signer.RelayKey(token1), signer.RelayKey(token1))
+		assert.NotEqual(t, signer.RelayKey(token1), signer.RelayKey(token2))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		signer := NewSessionSigner([]byte("secret"), time.Minute, time.Now)
+		token, err := signer.Issue(10)
+		assert.NoError(t, err)
+
+		signer.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+		_, err = signer.Verify(token)
+		assert.ErrorIs(t, err, ErrExpiredToken)
+	})
+}
@@ -0,0 +1,243 @@
+package console
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
+	"github.com/dimspell/gladiator/internal/console/auth"
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+)
+
+var (
+	errMissingToken    = errors.New("missing bearer token")
+	errUserIDMismatch  = errors.New("the request does not belong to the authenticated user")
+	errHostIDMismatch  = errors.New("the game can be hosted only by the authenticated user")
+	errUnauthenticated = errors.New("invalid or expired session token")
+	errAdminDisabled   = errors.New("admin access is not configured")
+	errNotAdmin        = errors.New("invalid admin token")
+	errUnknownUser     = errors.New("the user does not exist")
+	errRoleRequired    = errors.New("the action requires a higher role")
+)
+
+type authUserIDKey struct{}
+
+// AuthUserID returns the ID of the user authenticated with the bearer token.
+func AuthUserID(ctx context.Context) (int64, bool) {
+	userID, ok := ctx.Value(authUserIDKey{}).(int64)
+	return userID, ok
+}
+
+func withAuthUserID(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, authUserIDKey{}, userID)
+}
+
+// NewAuthInterceptor returns an interceptor that requires a valid session
+// token in the Authorization header. When the request message carries a user
+// ID, it must be the same as the one the token was issued for.
+func NewAuthInterceptor(sessions *auth.SessionSigner) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			userID, err := authenticate(sessions, req.Header())
+			if err != nil {
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			}
+
+			switch msg := req.Any().(type) {
+			case interface{ GetUserId() int64 }:
+				if msg.GetUserId() != userID {
+					return nil, connect.NewError(connect.CodePermissionDenied, errUserIDMismatch)
+				}
+			case interface{ GetHostUserId() int64 }:
+				if msg.GetHostUserId() != userID {
+					return nil, connect.NewError(connect.CodePermissionDenied, errHostIDMismatch)
+				}
+			}
+
+			return next(withAuthUserID(ctx, userID), req)
+		}
+	}
+}
+
+// authenticate verifies the bearer token from the Authorization header and
+// returns the ID of the user it belongs to.
+func authenticate(sessions *auth.SessionSigner, header http.Header) (int64, error) {
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return 0, errMissingToken
+	}
+
+	userID, err := sessions.Verify(token)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errUnauthenticated, err)
+	}
+	return userID, nil
+}
+
+// adminProcedureRoles lists the minimum role required to call the AdminService
+// procedures. Procedures missing here are available only to the admins.
+var adminProcedureRoles = map[string]wire.Role{
+	multiv1connect.AdminServiceListSessionsProcedure:      wire.RoleModerator,
+	multiv1connect.AdminServiceListRoomsProcedure:         wire.RoleModerator,
+	multiv1connect.AdminServiceDestroyRoomProcedure:       wire.RoleModerator,
+	multiv1connect.AdminServiceKickUserProcedure:          wire.RoleModerator,
+	multiv1connect.AdminServiceBroadcastMessageProcedure:  wire.RoleModerator,
+	multiv1connect.AdminServiceListBansProcedure:          wire.RoleModerator,
+	multiv1connect.AdminServiceListChatHistoryProcedure:   wire.RoleModerator,
+	multiv1connect.AdminServiceMuteUserProcedure:          wire.RoleModerator,
+	multiv1connect.AdminServiceUnmuteUserProcedure:        wire.RoleModerator,
+	multiv1connect.AdminServiceListModerationLogProcedure: wire.RoleModerator,
+	multiv1connect.AdminServiceListAnnouncementsProcedure: wire.RoleModerator,
+}
+
+// NewAdminInterceptor returns an interceptor that allows only the callers with
+// the role required by the procedure, see adminProcedureRoles.
+func NewAdminInterceptor(roles *roleAuthorizer) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			required, ok := adminProcedureRoles[req.Spec().Procedure]
+			if !ok {
+				required = wire.RoleAdmin
+			}
+			ctx, err := roles.Require(ctx, req.Header(), required)
+			if err != nil {
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+type authRoleKey struct{}
+
+// AuthRole returns the role of the caller authorized by the roleAuthorizer.
+func AuthRole(ctx context.Context) (wire.Role, bool) {
+	role, ok := ctx.Value(authRoleKey{}).(wire.Role)
+	return role, ok
+}
+
+type authCallerKey struct{}
+
+// AuthCaller returns the name of the caller authorized by the roleAuthorizer,
+// which is recorded as the author of the moderation actions. The callers using
+// the admin secret are named "admin".
+func AuthCaller(ctx context.Context) string {
+	caller, _ := ctx.Value(authCallerKey{}).(string)
+	return caller
+}
+
+// adminSecretCaller is the name of the caller authorized with the admin
+// secret.
+const adminSecretCaller = "admin"
+
+// roleAuthorizer resolves the role of the caller of a Connect handler. The
+// admin secret grants the admin role, so the console can be managed without
+// any user account. Otherwise, the role is read from the database for the user
+// owning the session token, so that the role changes apply immediately.
+type roleAuthorizer struct {
+	AdminSecret []byte
+	Sessions    *auth.SessionSigner
+	DB          *database.SQLite
+}
+
+// Require checks that the caller has at least the given role and returns the
+// context carrying the role and the name of the caller. The error has the
+// matching Connect code.
+func (a *roleAuthorizer) Require(ctx context.Context, header http.Header, required wire.Role) (context.Context, error) {
+	role, caller, err := a.resolve(ctx, header)
+	switch {
+	case errors.Is(err, errMissingToken), errors.Is(err, errUnauthenticated), errors.Is(err, errUnknownUser):
+		return ctx, connect.NewError(connect.CodeUnauthenticated, err)
+	case err != nil:
+		return ctx, connect.NewError(connect.CodeInternal, err)
+	case !role.Includes(required):
+		return ctx, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%w: %s", errRoleRequired, required))
+	}
+	ctx = context.WithValue(ctx, authRoleKey{}, role)
+	return context.WithValue(ctx, authCallerKey{}, caller), nil
+}
+
+func (a *roleAuthorizer) resolve(ctx context.Context, header http.Header) (wire.Role, string, error) {
+	err := authenticateAdmin(a.AdminSecret, header)
+	if err == nil {
+		return wire.RoleAdmin, adminSecretCaller, nil
+	}
+	if errors.Is(err, errMissingToken) {
+		return "", "", err
+	}
+
+	userID, err := authenticate(a.Sessions, header)
+	if err != nil {
+		return "", "", err
+	}
+	user, err := getUser(ctx, a.DB, userID)
+	if err != nil {
+		return "", "", err
+	}
+	return wire.Role(user.Role), user.Username, nil
+}
+
+// userRole reads the role of the user from the database.
+func userRole(ctx context.Context, db *database.SQLite, userID int64) (wire.Role, error) {
+	user, err := getUser(ctx, db, userID)
+	if err != nil {
+		return "", err
+	}
+	return wire.Role(user.Role), nil
+}
+
+func getUser(ctx context.Context, db *database.SQLite, userID int64) (database.User, error) {
+	user, err := db.Read.GetUserByID(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return database.User{}, errUnknownUser
+	}
+	return user, err
+}
+
+func roleToProto(role wire.Role) multiv1.Role {
+	switch role {
+	case wire.RoleAdmin:
+		return multiv1.Role_RoleAdmin
+	case wire.RoleModerator:
+		return multiv1.Role_RoleModerator
+	default:
+		return multiv1.Role_RolePlayer
+	}
+}
+
+func roleFromProto(role multiv1.Role) (wire.Role, bool) {
+	switch role {
+	case multiv1.Role_RoleAdmin:
+		return wire.RoleAdmin, true
+	case multiv1.Role_RoleModerator:
+		return wire.RoleModerator, true
+	case multiv1.Role_RolePlayer:
+		return wire.RolePlayer, true
+	default:
+		return "", false
+	}
+}
+
+// authenticateAdmin checks that the Authorization header carries the admin
+// secret configured in the console.
+func authenticateAdmin(secret []byte, header http.Header) error {
+	if len(secret) == 0 {
+		return errAdminDisabled
+	}
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return errMissingToken
+	}
+	if subtle.ConstantTimeCompare([]byte(token), secret) != 1 {
+		return errNotAdmin
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/console/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuthInterceptor(t *testing.T) {
+	sessions := auth.NewSessionSigner([]byte("secret"), time.Hour, time.Now)
+	token, err := sessions.Issue(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var calledWith int64
+	next := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		calledWith, _ = AuthUserID(ctx)
+		return nil, nil
+	}
+	handler := NewAuthInterceptor(sessions)(next)
+
+	call := func(msg any, authorization string) error {
+		calledWith = 0
+		var req connect.AnyRequest
+		switch m := msg.(type) {
+		case *multiv1.ListCharactersRequest:
+			req = connect.NewRequest(m)
+		case *multiv1.CreateGameRequest:
+			req = connect.NewRequest(m)
+		case *multiv1.ListGamesRequest:
+			req = connect.NewRequest(m)
+		}
+		if authorization != "" {
+			req.Header().Set("Authorization", authorization)
+		}
+		_, err := handler(t.Context(), req)
+		return err
+	}
+
+	assertCode := func(t *testing.T, code connect.Code, err error) {
+		t.Helper()
+		var connectErr *connect.Error
+		if assert.True(t, errors.As(err, &connectErr)) {
+			assert.Equal(t, code, connectErr.Code())
+		}
+	}
+
+	t.Run("matching user", func(t *testing.T) {
+		assert.NoError(t, call(&multiv1.ListCharactersRequest{UserId: 10}, "Bearer "+token))
+		assert.Equal(t, int64(10), calledWith)
+	})
+
+	t.Run("request without user", func(t *testing.T) {
+		assert.NoError(t, call(&multiv1.ListGamesRequest{}, "Bearer "+token))
+		assert.Equal(t, int64(10), calledWith)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		err := call(&multiv1.ListCharactersRequest{UserId: 10}, "")
+		assertCode(t, connect.CodeUnauthenticated, err)
+		assert.Zero(t, calledWith)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		err := call(&multiv1.ListCharactersRequest{UserId: 10}, "Bearer invalid")
+		assertCode(t, connect.CodeUnauthenticated, err)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		err := call(&multiv1.ListCharactersRequest{UserId: 11}, "Bearer "+token)
+		assertCode(t, connect.CodePermissionDenied, err)
+	})
+
+	t.Run("other host", func(t *testing.T) {
+		err := call(&multiv1.CreateGameRequest{HostUserId: 11}, "Bearer "+token)
+		assertCode(t, connect.CodePermissionDenied, err)
+	})
+}
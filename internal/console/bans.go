@@ -0,0 +1,108 @@
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/console/database"
+)
+
+var ErrInvalidBan = errors.New("ban must target either a user or an IP range")
+
+// BanError is returned when the user or the address is banned.
+type BanError struct {
+	Ban database.Ban
+}
+
+func (e *BanError) Error() string {
+	msg := "banned"
+	if e.Ban.ExpiresAt.Valid {
+		msg += " until " + time.Unix(e.Ban.ExpiresAt.Int64, 0).UTC().Format(time.RFC3339)
+	}
+	if e.Ban.Reason != "" {
+		msg += ": " + e.Ban.Reason
+	}
+	return msg
+}
+
+// BanList checks the users and the IP addresses against the bans stored in
+// the database.
+type BanList struct {
+	DB *database.SQLite
+
+	now func() time.Time
+}
+
+func NewBanList(db *database.SQLite, now func() time.Time) *BanList {
+	return &BanList{DB: db, now: now}
+}
+
+// Check returns BanError when there is an active ban for the user or the IP
+// address. Zero user ID or empty address are not checked.
+func (b *BanList) Check(ctx context.Context, userID int64, ip string) error {
+	bans, err := b.DB.Read.ListActiveBans(ctx, sql.NullInt64{Int64: b.now().Unix(), Valid: true})
+	if err != nil {
+		return err
+	}
+
+	addr, _ := netip.ParseAddr(ip)
+	for _, ban := range bans {
+		if banMatches(ban, userID, addr) {
+			return &BanError{Ban: ban}
+		}
+	}
+	return nil
+}
+
+// Create stores a new ban. The CIDR can be also a single IP address.
+func (b *BanList) Create(ctx context.Context, userID int64, cidr, reason, issuedBy string, duration time.Duration) (database.Ban, error) {
+	params := database.CreateBanParams{
+		Reason:    reason,
+		IssuedBy:  issuedBy,
+		CreatedAt: b.now().Unix(),
+	}
+
+	switch {
+	case userID != 0 && cidr == "":
+		params.UserID = sql.NullInt64{Int64: userID, Valid: true}
+	case userID == 0 && cidr != "":
+		prefix, err := parsePrefix(cidr)
+		if err != nil {
+			return database.Ban{}, fmt.Errorf("%w: %w", ErrInvalidBan, err)
+		}
+		params.Cidr = sql.NullString{String: prefix.String(), Valid: true}
+	default:
+		return database.Ban{}, ErrInvalidBan
+	}
+	if duration > 0 {
+		params.ExpiresAt = sql.NullInt64{Int64: b.now().Add(duration).Unix(), Valid: true}
+	}
+
+	return b.DB.Write.CreateBan(ctx, params)
+}
+
+func banMatches(ban database.Ban, userID int64, addr netip.Addr) bool {
+	if ban.UserID.Valid {
+		return userID != 0 && ban.UserID.Int64 == userID
+	}
+	if ban.Cidr.Valid && addr.IsValid() {
+		prefix, err := netip.ParsePrefix(ban.Cidr.String)
+		return err == nil && prefix.Contains(addr.Unmap())
+	}
+	return false
+}
+
+func parsePrefix(cidr string) (netip.Prefix, error) {
+	if addr, err := netip.ParseAddr(cidr); err == nil {
+		return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
@@ -0,0 +1,78 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBanList(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	newBans := func(t *testing.T) *BanList {
+		bans := NewBanList(setupDatabase(t), func() time.Time { return now })
+		return bans
+	}
+
+	assertBanned := func(t *testing.T, err error, reason string) {
+		t.Helper()
+		var banErr *BanError
+		if assert.ErrorAs(t, err, &banErr) {
+			assert.Equal(t, reason, banErr.Ban.Reason)
+		}
+	}
+
+	t.Run("bans the user", func(t *testing.T) {
+		bans := newBans(t)
+		_, err := bans.Create(t.Context(), 1, "", "cheating", "admin", 0)
+		assert.NoError(t, err)
+
+		assertBanned(t, bans.Check(t.Context(), 1, "10.0.0.1"), "cheating")
+		assert.NoError(t, bans.Check(t.Context(), 2, "10.0.0.1"))
+		assert.NoError(t, bans.Check(t.Context(), 0, ""))
+	})
+
+	t.Run("bans the range of addresses", func(t *testing.T) {
+		bans := newBans(t)
+		ban, err := bans.Create(t.Context(), 0, "10.1.2.3/16", "spam", "admin", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "10.1.0.0/16", ban.Cidr.String)
+
+		assertBanned(t, bans.Check(t.Context(), 0, "10.1.200.1"), "spam")
+		assertBanned(t, bans.Check(t.Context(), 1, "::ffff:10.1.0.1"), "spam")
+		assert.NoError(t, bans.Check(t.Context(), 1, "10.2.0.1"))
+		assert.NoError(t, bans.Check(t.Context(), 1, ""))
+	})
+
+	t.Run("bans a single address", func(t *testing.T) {
+		bans := newBans(t)
+		ban, err := bans.Create(t.Context(), 0, "192.0.2.7", "", "admin", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "192.0.2.7/32", ban.Cidr.String)
+
+		assert.EqualError(t, bans.Check(t.Context(), 0, "192.0.2.7"), "banned")
+		assert.NoError(t, bans.Check(t.Context(), 0, "192.0.2.8"))
+	})
+
+	t.Run("ban expires", func(t *testing.T) {
+		bans := newBans(t)
+		_, err := bans.Create(t.Context(), 1, "", "flood", "admin", time.Hour)
+		assert.NoError(t, err)
+		assert.EqualError(t, bans.Check(t.Context(), 1, ""), "banned until 2023-11-14T23:13:20Z: flood")
+
+		bans.now = func() time.Time { return now.Add(time.Hour + time.Second) }
+		assert.NoError(t, bans.Check(t.Context(), 1, ""))
+	})
+
+	t.Run("invalid ban", func(t *testing.T) {
+		bans := newBans(t)
+		_, err := bans.Create(t.Context(), 0, "", "", "admin", 0)
+		assert.ErrorIs(t, err, ErrInvalidBan)
+
+		_, err = bans.Create(t.Context(), 1, "10.0.0.0/8", "", "admin", 0)
+		assert.ErrorIs(t, err, ErrInvalidBan)
+
+		_, err = bans.Create(t.Context(), 0, "not-an-address", "", "admin", 0)
+		assert.ErrorIs(t, err, ErrInvalidBan)
+	})
+}
@@ -0,0 +1,117 @@
+package console
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/dimspell/gladiator/internal/app/logger/logging"
+)
+
+// CertReloader serves the certificate loaded from the files on disk and
+// allows to replace it without restarting the server, e.g. after it has been
+// renewed.
+type CertReloader struct {
+	CertFile string
+	KeyFile  string
+
+	mu          sync.RWMutex
+	cert        *tls.Certificate
+	fingerprint string
+}
+
+// NewCertReloader loads the certificate and the private key from PEM files.
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	r := &CertReloader{CertFile: certFile, KeyFile: keyFile}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// newStaticCertReloader wraps the certificate, which is never reloaded.
+func newStaticCertReloader(cert tls.Certificate) *CertReloader {
+	r := &CertReloader{}
+	r.set(&cert)
+	return r
+}
+
+// Reload reads the files again. The previous certificate is kept when the new
+// one cannot be loaded.
+func (r *CertReloader) Reload() error {
+	if r.CertFile == "" || r.KeyFile == "" {
+		return nil
+	}
+	cert, err := tls.LoadX509KeyPair(r.CertFile, r.KeyFile)
+	if err != nil {
+		return fmt.Errorf("could not load the certificate %q: %w", r.CertFile, err)
+	}
+	r.set(&cert)
+	return nil
+}
+
+func (r *CertReloader) set(cert *tls.Certificate) {
+	var fingerprint string
+	if len(cert.Certificate) > 0 {
+		fingerprint = CertificateFingerprint(cert.Certificate[0])
+	}
+
+	r.mu.Lock()
+	r.cert = cert
+	r.fingerprint = fingerprint
+	r.mu.Unlock()
+}
+
+// GetCertificate can be used as tls.Config.GetCertificate.
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// Fingerprint returns the SHA-256 fingerprint of the current certificate.
+func (r *CertReloader) Fingerprint() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.fingerprint
+}
+
+// CertificateFingerprint returns the hex-encoded SHA-256 hash of the
+// DER-encoded certificate.
+func CertificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// reloadOnSignal reloads the certificates every time the process receives
+// SIGHUP, until the context is cancelled.
+func reloadOnSignal(ctx context.Context, reloaders ...*CertReloader) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			for _, r := range reloaders {
+				if r == nil || r.CertFile == "" {
+					continue
+				}
+				if err := r.Reload(); err != nil {
+					slog.Error("Could not reload the certificate", logging.Error(err))
+					continue
+				}
+				slog.Info("Reloaded the certificate", "file", r.CertFile, "fingerprint", r.Fingerprint())
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package console
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func helperWriteCertificate(t *testing.T, dir string) (certFile, keyFile string, der []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err = x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile, der
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, first := helperWriteCertificate(t, dir)
+
+	reloader, err := NewCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, CertificateFingerprint(first), reloader.Fingerprint())
+
+	_, _, second := helperWriteCertificate(t, dir)
+	assert.NoError(t, reloader.Reload())
+	assert.Equal(t, CertificateFingerprint(second), reloader.Fingerprint())
+
+	cert, err := reloader.GetCertificate(nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, second, cert.Certificate[0])
+	}
+
+	// The previous certificate is kept, when the files are broken.
+	assert.NoError(t, os.WriteFile(certFile, []byte("broken"), 0o600))
+	assert.Error(t, reloader.Reload())
+	assert.Equal(t, CertificateFingerprint(second), reloader.Fingerprint())
+
+	_, err = NewCertReloader(filepath.Join(dir, "missing.pem"), keyFile)
+	assert.Error(t, err)
+}
+
+func TestConsole_HandlersTLS(t *testing.T) {
+	certFile, keyFile, der := helperWriteCertificate(t, t.TempDir())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	_ = listener.Close()
+
+	c := NewConsole(setupDatabase(t), WithConsoleAddr(addr, "https://"+addr), WithTLS(certFile, keyFile))
+	start, shutdown := c.Handlers()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go func() { _ = start(ctx) }()
+	defer shutdown(context.Background())
+
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(leaf)
+	client := &http.Client{
+		Timeout: time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{RootCAs: pool, ServerName: "localhost"},
+			ForceAttemptHTTP2: true,
+		},
+	}
+
+	var res *http.Response
+	for range 50 {
+		res, err = client.Get("https://" + addr + "/_health")
+		if err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if assert.NoError(t, err) {
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, 2, res.ProtoMajor)
+	}
+}
@@ -0,0 +1,142 @@
+package console
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+)
+
+var (
+	ErrChannelNotFound = errors.New("channel not found")
+	ErrChannelFull     = errors.New("channel is full")
+	ErrChannelPassword = errors.New("wrong channel password")
+	ErrChannelLevel    = errors.New("character level is too low")
+)
+
+// ChannelList reads the lobby channels from the database. The first channel
+// is the default one, which the users enter after connecting to the lobby.
+//
+// The game client cannot send the channel password, so the protected channels
+// can be entered only by the moderators, unless the password is provided by
+// another client speaking the lobby protocol.
+type ChannelList struct {
+	DB *database.SQLite
+}
+
+func NewChannelList(db *database.SQLite) *ChannelList {
+	return &ChannelList{DB: db}
+}
+
+// List returns all channels ordered by their creation.
+func (c *ChannelList) List(ctx context.Context) ([]database.Channel, error) {
+	return c.DB.Read.ListChannels(ctx)
+}
+
+// Get returns the channel by its name or the default channel when the name is
+// empty.
+func (c *ChannelList) Get(ctx context.Context, name string) (database.Channel, error) {
+	if name == "" {
+		channels, err := c.List(ctx)
+		if err != nil {
+			return database.Channel{}, err
+		}
+		if len(channels) == 0 {
+			return database.Channel{}, fmt.Errorf("%w: there are no channels", ErrChannelNotFound)
+		}
+		return channels[0], nil
+	}
+
+	channel, err := c.DB.Read.GetChannelByName(ctx, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return database.Channel{}, fmt.Errorf("%w: %s", ErrChannelNotFound, name)
+	}
+	return channel, err
+}
+
+// SetMOTD changes the message of the day shown to the users entering the
+// channel. The empty message falls back to the global one.
+func (c *ChannelList) SetMOTD(ctx context.Context, name, motd string) error {
+	updated, err := c.DB.Write.UpdateChannelMOTD(ctx, database.UpdateChannelMOTDParams{
+		Motd: motd,
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w: %s", ErrChannelNotFound, name)
+	}
+	return nil
+}
+
+// Admit returns an error when the user is not allowed to enter the channel,
+// which has already the given number of members. Moderators are not
+// restricted.
+func (c *ChannelList) Admit(channel database.Channel, role wire.Role, members int, level int64, password string) error {
+	if role.Includes(wire.RoleModerator) {
+		return nil
+	}
+	if channel.Password.Valid && subtle.ConstantTimeCompare([]byte(channel.Password.String), []byte(password)) != 1 {
+		return ErrChannelPassword
+	}
+	if channel.MaxUsers > 0 && int64(members) >= channel.MaxUsers {
+		return ErrChannelFull
+	}
+	if level < channel.MinLevel {
+		return fmt.Errorf("%w: level %d is required", ErrChannelLevel, channel.MinLevel)
+	}
+	return nil
+}
+
+// CharacterLevel returns the level of the character the user plays with, or
+// zero when it is not known.
+func (c *ChannelList) CharacterLevel(ctx context.Context, userID, characterID int64) int64 {
+	level, err := c.DB.Read.GetCharacterLevel(ctx, database.GetCharacterLevelParams{
+		ID:     characterID,
+		UserID: userID,
+	})
+	if err != nil {
+		return 0
+	}
+	return level
+}
+
+var _ multiv1connect.ChannelServiceHandler = (*channelServiceServer)(nil)
+
+type channelServiceServer struct {
+	Channels    *ChannelList
+	Multiplayer *Multiplayer
+}
+
+// ListChannels returns the lobby channels with the number of users present.
+func (s *channelServiceServer) ListChannels(ctx context.Context, req *connect.Request[multiv1.ListChannelsRequest]) (*connect.Response[multiv1.ListChannelsResponse], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	channels, err := s.Channels.List(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := make([]*multiv1.Channel, len(channels))
+	for i, channel := range channels {
+		resp[i] = &multiv1.Channel{
+			Name:        channel.Name,
+			Description: channel.Description,
+			HasPassword: channel.Password.Valid,
+			MaxUsers:    channel.MaxUsers,
+			MinLevel:    channel.MinLevel,
+			Users:       int64(s.Multiplayer.CountChannelSessions(channel.Name)),
+		}
+	}
+	return connect.NewResponse(&multiv1.ListChannelsResponse{Channels: resp}), nil
+}
@@ -0,0 +1,134 @@
+package console
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelList(t *testing.T) {
+	channels := NewChannelList(setupDatabase(t))
+
+	t.Run("default channel", func(t *testing.T) {
+		channel, err := channels.Get(t.Context(), "")
+		assert.NoError(t, err)
+		assert.Equal(t, "DISPEL", channel.Name)
+	})
+
+	t.Run("unknown channel", func(t *testing.T) {
+		_, err := channels.Get(t.Context(), "UNKNOWN")
+		assert.ErrorIs(t, err, ErrChannelNotFound)
+	})
+
+	t.Run("admit", func(t *testing.T) {
+		channel := database.Channel{
+			Name:     "VETERANS",
+			Password: sql.NullString{String: "secret", Valid: true},
+			MaxUsers: 2,
+			MinLevel: 10,
+		}
+
+		assert.NoError(t, channels.Admit(channel, wire.RolePlayer, 1, 10, "secret"))
+		assert.ErrorIs(t, channels.Admit(channel, wire.RolePlayer, 1, 10, "wrong"), ErrChannelPassword)
+		assert.ErrorIs(t, channels.Admit(channel, wire.RolePlayer, 2, 10, "secret"), ErrChannelFull)
+		assert.ErrorIs(t, channels.Admit(channel, wire.RolePlayer, 1, 9, "secret"), ErrChannelLevel)
+		assert.NoError(t, channels.Admit(channel, wire.RoleModerator, 2, 0, ""))
+	})
+
+	t.Run("message of the day", func(t *testing.T) {
+		mp := NewMultiplayer()
+		mp.Channels = channels
+		mp.MOTD = "Have fun"
+		assert.Equal(t, "Have fun", mp.ChannelMOTD(t.Context(), "DISPEL"))
+
+		assert.NoError(t, channels.SetMOTD(t.Context(), "DISPEL", "Welcome to DISPEL"))
+		assert.Equal(t, "Welcome to DISPEL", mp.ChannelMOTD(t.Context(), "DISPEL"))
+
+		assert.NoError(t, channels.SetMOTD(t.Context(), "DISPEL", ""))
+		assert.Equal(t, "Have fun", mp.ChannelMOTD(t.Context(), "DISPEL"))
+
+		assert.ErrorIs(t, channels.SetMOTD(t.Context(), "UNKNOWN", "hello"), ErrChannelNotFound)
+	})
+}
+
+func TestMultiplayer_JoinChannel(t *testing.T) {
+	db := setupDatabase(t)
+	if _, err := db.Write.CreateChannel(t.Context(), database.CreateChannelParams{Name: "PVP", MaxUsers: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	mp := NewMultiplayer()
+	mp.Channels = NewChannelList(db)
+
+	newSession := func(userID int64, channel string) (*UserSession, *recordingConn) {
+		conn := &recordingConn{}
+		session := NewUserSession(userID, conn)
+		session.User = wire.User{UserID: userID, Username: "user"}
+		session.Channel = channel
+		mp.AddUserSession(userID, session)
+		return session, conn
+	}
+	events := func(conn *recordingConn) []wire.EventType {
+		conn.mu.Lock()
+		defer conn.mu.Unlock()
+		var types []wire.EventType
+		for _, payload := range conn.written {
+			types = append(types, wire.ParseEventType(payload))
+		}
+		conn.written = nil
+		return types
+	}
+
+	first, firstConn := newSession(1, "DISPEL")
+	second, secondConn := newSession(2, "DISPEL")
+	_, thirdConn := newSession(3, "PVP")
+
+	t.Run("moves the user to another channel", func(t *testing.T) {
+		assert.NoError(t, mp.JoinChannel(t.Context(), first, wire.ChannelJoin{Channel: "PVP"}))
+		assert.Equal(t, "PVP", first.Channel)
+
+		assert.Equal(t, []wire.EventType{wire.JoinedChannel, wire.JoinLobby}, events(firstConn))
+		assert.Equal(t, []wire.EventType{wire.LeaveLobby}, events(secondConn))
+		assert.Equal(t, []wire.EventType{wire.JoinLobby}, events(thirdConn))
+		assert.Equal(t, 2, mp.CountChannelSessions("PVP"))
+	})
+
+	t.Run("chat is scoped to the channel", func(t *testing.T) {
+		mp.PostChatMessage(t.Context(), first, "hello")
+
+		assert.Equal(t, []wire.EventType{wire.Chat}, events(firstConn))
+		assert.Empty(t, events(secondConn))
+		assert.Equal(t, []wire.EventType{wire.Chat}, events(thirdConn))
+	})
+
+	t.Run("chat is sent on behalf of the session", func(t *testing.T) {
+		first.User.Username = "first"
+		mp.PostChatMessage(t.Context(), first, "hello")
+		events(firstConn)
+
+		thirdConn.mu.Lock()
+		assert.Len(t, thirdConn.written, 1)
+		_, m, err := wire.DecodeTyped[wire.ChatMessage](thirdConn.written[0])
+		thirdConn.written = nil
+		thirdConn.mu.Unlock()
+		assert.NoError(t, err)
+		assert.Equal(t, "1", m.From)
+		assert.Equal(t, wire.ChatMessage{User: "first", Text: "hello"}, m.Content)
+	})
+
+	t.Run("rejects when the channel is full", func(t *testing.T) {
+		assert.ErrorIs(t, mp.JoinChannel(t.Context(), second, wire.ChannelJoin{Channel: "PVP"}), ErrChannelFull)
+		assert.Equal(t, "DISPEL", second.Channel)
+
+		assert.Equal(t, []wire.EventType{wire.JoinChannelRejected}, events(secondConn))
+		assert.Empty(t, events(firstConn))
+	})
+
+	t.Run("rejects unknown channel", func(t *testing.T) {
+		assert.ErrorIs(t, mp.JoinChannel(t.Context(), second, wire.ChannelJoin{Channel: "UNKNOWN"}), ErrChannelNotFound)
+		assert.Equal(t, []wire.EventType{wire.JoinChannelRejected}, events(secondConn))
+	})
+}
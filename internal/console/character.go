@@ -19,7 +19,12 @@ import (
 var _ multiv1connect.CharacterServiceHandler = (*characterServiceServer)(nil)
 
 type characterServiceServer struct {
-	DB *database.SQLite
+	DB    *database.SQLite
+	Names *NamePolicy
+
+	// Matches is given the changes of the stats of the characters, which are
+	// playing a match.
+	Matches *MatchHistory
 }
 
 // ListCharacters returns a list of all characters of a user.
@@ -165,6 +170,9 @@ func (s *characterServiceServer) CreateCharacter(ctx context.Context, req *conne
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
+	if err := s.Names.Validate(req.Msg.CharacterName); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid character name: %w", err))
+	}
 
 	tx, queries, err := s.DB.WithTx(ctx)
 	if err != nil {
@@ -240,6 +248,21 @@ func (s *characterServiceServer) PutStats(ctx context.Context, req *connect.Requ
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	// The stats before the update are needed to know how they have changed
+	// during the match.
+	var before database.Character
+	playing := s.Matches.Playing(req.Msg.UserId)
+	if playing {
+		before, err = queries.FindCharacter(ctx, database.FindCharacterParams{
+			CharacterName: req.Msg.CharacterName,
+			UserID:        req.Msg.UserId,
+		})
+		if err != nil {
+			slog.Warn("could not get the character stats before the match update", logging.Error(err), "user_id", req.Msg.UserId)
+			playing = false
+		}
+	}
+
 	info := model.ParseCharacterInfo(req.Msg.Stats)
 	if err := queries.UpdateCharacterStats(ctx, database.UpdateCharacterStatsParams{
 		Strength:             int64(info.Strength),
@@ -283,6 +306,13 @@ func (s *characterServiceServer) PutStats(ctx context.Context, req *connect.Requ
 	if err := tx.Commit(); err != nil {
 		return nil, connect.NewError(connect.CodeAborted, err)
 	}
+	if playing {
+		s.Matches.RecordStats(req.Msg.UserId, before.ID,
+			int64(info.ScorePoints)-before.ScorePoints,
+			int64(info.ExperiencePoints)-before.ExperiencePoints,
+			int64(info.Money)-before.Money,
+		)
+	}
 
 	resp := connect.NewResponse(&multiv1.PutStatsResponse{})
 	return resp, nil
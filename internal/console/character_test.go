@@ -158,3 +158,25 @@ func TestCharacterServiceServer_ListCharacters(t *testing.T) {
 		assert.Equalf(t, uint16(32), stats.DarkMagic, "stats.DarkMagic")
 	})
 }
+
+func TestCharacterServiceServer_CreateCharacter(t *testing.T) {
+	t.Run("Name rejected by the policy", func(t *testing.T) {
+		// Arrange
+		db := setupDatabase(t)
+
+		// Act
+		resp, err := (&characterServiceServer{
+			DB:    db,
+			Names: &DefaultConfig().CharacterNamePolicy,
+		}).CreateCharacter(t.Context(), connect.NewRequest(&multiv1.CreateCharacterRequest{
+			UserId:        1,
+			CharacterName: "system-info",
+			Stats:         make([]byte, 56),
+		}))
+
+		// Assert
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		assert.ErrorIs(t, err, ErrNameReserved)
+		assert.Nil(t, resp)
+	})
+}
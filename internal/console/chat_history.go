@@ -0,0 +1,116 @@
+package console
+
+import (
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+)
+
+// ChatHistory stores the messages sent to the lobby channels, so the users
+// who join a channel can see the recent conversation.
+type ChatHistory struct {
+	DB *database.SQLite
+
+	// Replay is the number of the recent messages sent to the user entering
+	// the channel.
+	Replay int
+
+	// Limit is the number of the messages kept in each channel. The older
+	// messages are deleted. There is no limit when it is zero.
+	Limit int
+
+	// TTL is how long the messages are kept. They are kept forever when it is
+	// zero.
+	TTL time.Duration
+
+	now func() time.Time
+}
+
+func NewChatHistory(db *database.SQLite, replay, limit int, ttl time.Duration, now func() time.Time) *ChatHistory {
+	return &ChatHistory{DB: db, Replay: replay, Limit: limit, TTL: ttl, now: now}
+}
+
+// chatPurgeInterval is how often the expired chat messages are deleted.
+const chatPurgeInterval = time.Hour
+
+// Record stores the message sent by the user to the channel and removes the
+// oldest messages of the channel exceeding the limit. Nothing is stored when
+// the history is nil.
+func (h *ChatHistory) Record(ctx context.Context, channel string, userID int64, msg wire.ChatMessage) error {
+	if h == nil {
+		return nil
+	}
+
+	if _, err := h.DB.Write.CreateChatMessage(ctx, database.CreateChatMessageParams{
+		Channel:   channel,
+		UserID:    userID,
+		Username:  msg.User,
+		Text:      msg.Text,
+		CreatedAt: h.now().Unix(),
+	}); err != nil {
+		return err
+	}
+
+	if h.Limit > 0 {
+		if _, err := h.DB.Write.TrimChatMessages(ctx, database.TrimChatMessagesParams{
+			Channel:   channel,
+			Channel_2: channel,
+			Limit:     int64(h.Limit),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Purge deletes the messages of all channels older than the TTL. It is run
+// periodically, see chatPurgeInterval, because the expired messages are
+// already skipped, when the history is replayed.
+func (h *ChatHistory) Purge(ctx context.Context) error {
+	if h == nil || h.TTL <= 0 {
+		return nil
+	}
+	_, err := h.DB.Write.DeleteChatMessagesBefore(ctx, h.now().Add(-h.TTL).Unix())
+	return err
+}
+
+// Recent returns the messages to replay to the user entering the channel,
+// ordered from the oldest.
+func (h *ChatHistory) Recent(ctx context.Context, channel string) ([]database.ChatMessage, error) {
+	if h == nil || h.Replay <= 0 {
+		return nil, nil
+	}
+
+	messages, err := h.List(ctx, channel, 0, h.Replay)
+	if err != nil {
+		return nil, err
+	}
+	if h.TTL > 0 {
+		expired := h.now().Add(-h.TTL).Unix()
+		for i, msg := range messages {
+			if msg.CreatedAt < expired {
+				messages = messages[:i]
+				break
+			}
+		}
+	}
+	slices.Reverse(messages)
+	return messages, nil
+}
+
+// List returns a page of the messages older than the message with the given
+// ID, ordered from the newest. Zero ID lists the most recent messages.
+func (h *ChatHistory) List(ctx context.Context, channel string, beforeID int64, limit int) ([]database.ChatMessage, error) {
+	if beforeID <= 0 {
+		beforeID = math.MaxInt64
+	}
+	return h.DB.Read.ListChatMessages(ctx, database.ListChatMessagesParams{
+		Channel: channel,
+		ID:      beforeID,
+		Limit:   int64(limit),
+	})
+}
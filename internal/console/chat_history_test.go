@@ -0,0 +1,125 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatHistory(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	newHistory := func(t *testing.T, replay, limit int, ttl time.Duration) *ChatHistory {
+		history := NewChatHistory(setupDatabase(t), replay, limit, ttl, func() time.Time { return now })
+		return history
+	}
+	texts := func(t *testing.T, history *ChatHistory, channel string) []string {
+		t.Helper()
+		messages, err := history.Recent(t.Context(), channel)
+		assert.NoError(t, err)
+		var texts []string
+		for _, msg := range messages {
+			texts = append(texts, msg.Username+": "+msg.Text)
+		}
+		return texts
+	}
+
+	t.Run("replays the recent messages of the channel", func(t *testing.T) {
+		history := newHistory(t, 2, 0, 0)
+		assert.NoError(t, history.Record(t.Context(), "DISPEL", 1, wire.ChatMessage{User: "archer", Text: "one"}))
+		assert.NoError(t, history.Record(t.Context(), "PVP", 2, wire.ChatMessage{User: "mage", Text: "other"}))
+		assert.NoError(t, history.Record(t.Context(), "DISPEL", 2, wire.ChatMessage{User: "mage", Text: "two"}))
+		assert.NoError(t, history.Record(t.Context(), "DISPEL", 1, wire.ChatMessage{User: "archer", Text: "three"}))
+
+		assert.Equal(t, []string{"mage: two", "archer: three"}, texts(t, history, "DISPEL"))
+		assert.Equal(t, []string{"mage: other"}, texts(t, history, "PVP"))
+	})
+
+	t.Run("keeps the limited number of messages per channel", func(t *testing.T) {
+		history := newHistory(t, 10, 2, 0)
+		for _, text := range []string{"one", "two", "three"} {
+			assert.NoError(t, history.Record(t.Context(), "DISPEL", 1, wire.ChatMessage{User: "archer", Text: text}))
+		}
+		assert.NoError(t, history.Record(t.Context(), "PVP", 1, wire.ChatMessage{User: "archer", Text: "other"}))
+
+		assert.Equal(t, []string{"archer: two", "archer: three"}, texts(t, history, "DISPEL"))
+		assert.Equal(t, []string{"archer: other"}, texts(t, history, "PVP"))
+	})
+
+	t.Run("deletes the expired messages", func(t *testing.T) {
+		history := newHistory(t, 10, 0, time.Hour)
+		assert.NoError(t, history.Record(t.Context(), "DISPEL", 1, wire.ChatMessage{User: "archer", Text: "old"}))
+
+		now = now.Add(2 * time.Hour)
+		assert.Empty(t, texts(t, history, "DISPEL"))
+
+		assert.NoError(t, history.Record(t.Context(), "DISPEL", 1, wire.ChatMessage{User: "archer", Text: "new"}))
+		messages, err := history.List(t.Context(), "DISPEL", 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 2, "the messages are purged periodically, not on insert")
+
+		assert.NoError(t, history.Purge(t.Context()))
+		messages, err = history.List(t.Context(), "DISPEL", 0, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, messages, 1) {
+			assert.Equal(t, "new", messages[0].Text)
+		}
+	})
+
+	t.Run("lists the pages from the newest", func(t *testing.T) {
+		history := newHistory(t, 0, 0, 0)
+		for _, text := range []string{"one", "two", "three"} {
+			assert.NoError(t, history.Record(t.Context(), "DISPEL", 1, wire.ChatMessage{User: "archer", Text: text}))
+		}
+
+		page, err := history.List(t.Context(), "DISPEL", 0, 2)
+		assert.NoError(t, err)
+		if assert.Len(t, page, 2) {
+			assert.Equal(t, "three", page[0].Text)
+			assert.Equal(t, "two", page[1].Text)
+		}
+
+		page, err = history.List(t.Context(), "DISPEL", page[1].ID, 2)
+		assert.NoError(t, err)
+		if assert.Len(t, page, 1) {
+			assert.Equal(t, "one", page[0].Text)
+		}
+
+		recent, err := history.Recent(t.Context(), "DISPEL")
+		assert.NoError(t, err)
+		assert.Empty(t, recent)
+	})
+
+	t.Run("nil history records nothing", func(t *testing.T) {
+		var history *ChatHistory
+		assert.NoError(t, history.Record(t.Context(), "DISPEL", 1, wire.ChatMessage{Text: "lost"}))
+		recent, err := history.Recent(t.Context(), "DISPEL")
+		assert.NoError(t, err)
+		assert.Empty(t, recent)
+	})
+}
+
+func TestMultiplayer_ReplayChatHistory(t *testing.T) {
+	mp := NewMultiplayer()
+	mp.History = NewChatHistory(setupDatabase(t), 20, 0, 0, time.Now)
+
+	sender := NewUserSession(1, &recordingConn{})
+	sender.User = wire.User{UserID: 1, Username: "archer"}
+	sender.Channel = "DISPEL"
+
+	// The name of the sender is taken from the session, not from the message.
+	mp.recordChatMessage(t.Context(), sender, wire.ChatMessage{User: "admin", Text: "hello"})
+
+	conn := &recordingConn{}
+	session := NewUserSession(2, conn)
+	mp.ReplayChatHistory(t.Context(), session, "DISPEL")
+
+	if assert.Len(t, conn.written, 1) {
+		et, msg, err := wire.DecodeTyped[wire.ChatMessage](conn.written[0])
+		assert.NoError(t, err)
+		assert.Equal(t, wire.Chat, et)
+		assert.Equal(t, "1", msg.From)
+		assert.Equal(t, wire.ChatMessage{User: "archer", Text: "hello"}, msg.Content)
+	}
+}
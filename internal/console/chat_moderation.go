@@ -0,0 +1,278 @@
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/app/logger/logging"
+	"github.com/dimspell/gladiator/internal/console/database"
+)
+
+var (
+	ErrChatFlood    = errors.New("too many messages, slow down")
+	ErrChatFiltered = errors.New("message contains a forbidden word")
+)
+
+// Actions recorded in the moderation log.
+const (
+	ModerationMute         = "mute"
+	ModerationUnmute       = "unmute"
+	ModerationFlood        = "flood"
+	ModerationFilterMask   = "filter-mask"
+	ModerationFilterReject = "filter-reject"
+)
+
+// MuteError is returned when the user cannot chat, because it has been muted.
+type MuteError struct {
+	Mute database.Mute
+}
+
+func (e *MuteError) Error() string {
+	msg := "you are muted until " + time.Unix(e.Mute.ExpiresAt, 0).UTC().Format(time.RFC3339)
+	if e.Mute.Reason != "" {
+		msg += ": " + e.Mute.Reason
+	}
+	return msg
+}
+
+// FloodPolicy limits how many chat messages a user can send. Each user has a
+// bucket of Burst tokens, one token is spent on every message and one is
+// given back every Interval.
+type FloodPolicy struct {
+	Burst    int
+	Interval time.Duration
+}
+
+// FilterMode tells what happens to the message containing a filtered word.
+type FilterMode string
+
+const (
+	// FilterMask replaces the filtered words with asterisks.
+	FilterMask FilterMode = "mask"
+
+	// FilterReject drops the whole message.
+	FilterReject FilterMode = "reject"
+)
+
+// WordFilter finds the forbidden words in the chat messages, regardless of
+// the letter case.
+type WordFilter struct {
+	Words []string
+	Mode  FilterMode
+}
+
+// Apply returns the message with the forbidden words masked, or
+// ErrChatFiltered when the filter rejects such messages. The second value
+// reports whether any word has been found.
+func (f *WordFilter) Apply(text string) (string, bool, error) {
+	lower := strings.ToLower(text)
+	mask := make([]bool, len(text))
+	found := false
+	for _, word := range f.Words {
+		word = strings.ToLower(word)
+		if word == "" {
+			continue
+		}
+		for offset := 0; ; {
+			i := strings.Index(lower[offset:], word)
+			if i < 0 {
+				break
+			}
+			for j := offset + i; j < offset+i+len(word); j++ {
+				mask[j] = true
+			}
+			found = true
+			offset += i + len(word)
+		}
+	}
+	if !found {
+		return text, false, nil
+	}
+	if f.Mode == FilterReject {
+		return "", true, ErrChatFiltered
+	}
+
+	// The lowered text has the same length only for ASCII, the game client
+	// does not send anything else anyway.
+	if len(lower) != len(text) {
+		return "", true, ErrChatFiltered
+	}
+	masked := []byte(text)
+	for i := range masked {
+		if mask[i] {
+			masked[i] = '*'
+		}
+	}
+	return string(masked), true, nil
+}
+
+// ChatModeration protects the lobby chat from flooding and abuse. The mutes
+// and the moderation log are stored in the database, the flood buckets are
+// kept in memory.
+type ChatModeration struct {
+	DB     *database.SQLite
+	Flood  FloodPolicy
+	Filter WordFilter
+
+	mu      sync.Mutex
+	buckets map[int64]*floodBucket
+
+	now func() time.Time
+}
+
+type floodBucket struct {
+	tokens    float64
+	updatedAt time.Time
+
+	// throttled is set after the first dropped message, so the flood is
+	// logged once and not for every message.
+	throttled bool
+}
+
+func NewChatModeration(db *database.SQLite, flood FloodPolicy, filter WordFilter, now func() time.Time) *ChatModeration {
+	return &ChatModeration{
+		DB:      db,
+		Flood:   flood,
+		Filter:  filter,
+		buckets: make(map[int64]*floodBucket),
+		now:     now,
+	}
+}
+
+// Allow spends a token of the user and reports whether the message can be
+// sent. The second value is true only for the first message dropped after
+// the user has started flooding. Everything is allowed when the moderation
+// is nil or the flood policy is not set.
+func (m *ChatModeration) Allow(userID int64) (allowed bool, started bool) {
+	if m == nil || m.Flood.Burst <= 0 || m.Flood.Interval <= 0 {
+		return true, false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	bucket, ok := m.buckets[userID]
+	if !ok {
+		bucket = &floodBucket{tokens: float64(m.Flood.Burst), updatedAt: now}
+		m.buckets[userID] = bucket
+	}
+	refill := float64(now.Sub(bucket.updatedAt)) / float64(m.Flood.Interval)
+	bucket.tokens = min(float64(m.Flood.Burst), bucket.tokens+refill)
+	bucket.updatedAt = now
+
+	if bucket.tokens < 1 {
+		started = !bucket.throttled
+		bucket.throttled = true
+		return false, started
+	}
+	bucket.tokens--
+	bucket.throttled = false
+	return true, false
+}
+
+// Forget removes the flood bucket of the user, who has left the lobby.
+func (m *ChatModeration) Forget(userID int64) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	delete(m.buckets, userID)
+	m.mu.Unlock()
+}
+
+// CheckMute returns MuteError when the user is muted.
+func (m *ChatModeration) CheckMute(ctx context.Context, userID int64) error {
+	if m == nil {
+		return nil
+	}
+	mute, err := m.DB.Read.GetActiveMute(ctx, database.GetActiveMuteParams{
+		UserID:    userID,
+		ExpiresAt: m.now().Unix(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return &MuteError{Mute: mute}
+}
+
+// Mute prevents the user from chatting for the given duration. The previous
+// mute of the user is replaced.
+func (m *ChatModeration) Mute(ctx context.Context, userID int64, duration time.Duration, reason, issuedBy string) (database.Mute, error) {
+	if duration <= 0 {
+		return database.Mute{}, fmt.Errorf("mute duration must be positive")
+	}
+	now := m.now()
+	mute, err := m.DB.Write.UpsertMute(ctx, database.UpsertMuteParams{
+		UserID:    userID,
+		Reason:    reason,
+		IssuedBy:  issuedBy,
+		CreatedAt: now.Unix(),
+		ExpiresAt: now.Add(duration).Unix(),
+	})
+	if err != nil {
+		return database.Mute{}, err
+	}
+	m.Audit(ctx, ModerationMute, userID, "", issuedBy, reason)
+	return mute, nil
+}
+
+// Unmute lifts the mute of the user and reports whether the user was muted.
+func (m *ChatModeration) Unmute(ctx context.Context, userID int64, issuedBy string) (bool, error) {
+	deleted, err := m.DB.Write.DeleteMute(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if deleted == 0 {
+		return false, nil
+	}
+	m.Audit(ctx, ModerationUnmute, userID, "", issuedBy, "")
+	return true, nil
+}
+
+// Apply runs the word filter over the message sent by the user to the
+// channel. The filtered messages are recorded in the moderation log.
+func (m *ChatModeration) Apply(ctx context.Context, userID int64, channel, text string) (string, error) {
+	if m == nil {
+		return text, nil
+	}
+	filtered, found, err := m.Filter.Apply(text)
+	if !found {
+		return text, nil
+	}
+	if err != nil {
+		m.Audit(ctx, ModerationFilterReject, userID, channel, "filter", text)
+		return "", err
+	}
+	m.Audit(ctx, ModerationFilterMask, userID, channel, "filter", text)
+	return filtered, nil
+}
+
+// Audit records the moderation action in the log. The failures are only
+// logged, they never stop the action.
+func (m *ChatModeration) Audit(ctx context.Context, action string, userID int64, channel, actor, reason string) {
+	if m == nil {
+		return
+	}
+	slog.Info("Chat moderation", "action", action, "userId", userID, "channel", channel, "actor", actor, "reason", reason)
+
+	if err := m.DB.Write.CreateModerationLogEntry(ctx, database.CreateModerationLogEntryParams{
+		Action:    action,
+		UserID:    userID,
+		Channel:   channel,
+		Actor:     actor,
+		Reason:    reason,
+		CreatedAt: m.now().Unix(),
+	}); err != nil {
+		slog.Warn("Could not record the moderation action", logging.Error(err), "action", action, "userId", userID)
+	}
+}
@@ -0,0 +1,174 @@
+package console
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  WordFilter
+		text    string
+		want    string
+		found   bool
+		wantErr error
+	}{
+		{name: "clean text", filter: WordFilter{Words: []string{"troll"}, Mode: FilterMask}, text: "hello", want: "hello"},
+		{name: "masks every occurrence", filter: WordFilter{Words: []string{"troll"}, Mode: FilterMask}, text: "Troll, TROLL and trolls", want: "*****, ***** and *****s", found: true},
+		{name: "masks overlapping words", filter: WordFilter{Words: []string{"bad", "badger"}, Mode: FilterMask}, text: "a badger", want: "a ******", found: true},
+		{name: "rejects", filter: WordFilter{Words: []string{"troll"}, Mode: FilterReject}, text: "you troll", found: true, wantErr: ErrChatFiltered},
+		{name: "empty word is ignored", filter: WordFilter{Words: []string{""}, Mode: FilterMask}, text: "hello", want: "hello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found, err := tt.filter.Apply(tt.text)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChatModeration(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	newModeration := func(t *testing.T) *ChatModeration {
+		m := NewChatModeration(setupDatabase(t), FloodPolicy{Burst: 2, Interval: time.Second}, WordFilter{Words: []string{"troll"}, Mode: FilterMask}, func() time.Time { return now })
+		return m
+	}
+	auditLog := func(t *testing.T, m *ChatModeration) []string {
+		t.Helper()
+		entries, err := m.DB.Read.ListModerationLog(t.Context(), database.ListModerationLogParams{ID: math.MaxInt64, Limit: 100})
+		assert.NoError(t, err)
+		var actions []string
+		for _, entry := range entries {
+			actions = append(actions, entry.Action+" by "+entry.Actor)
+		}
+		return actions
+	}
+
+	t.Run("limits the burst of messages", func(t *testing.T) {
+		m := newModeration(t)
+
+		allowed, _ := m.Allow(1)
+		assert.True(t, allowed)
+		allowed, _ = m.Allow(1)
+		assert.True(t, allowed)
+
+		allowed, started := m.Allow(1)
+		assert.False(t, allowed)
+		assert.True(t, started)
+		allowed, started = m.Allow(1)
+		assert.False(t, allowed)
+		assert.False(t, started)
+
+		allowed, _ = m.Allow(2)
+		assert.True(t, allowed, "other users are not limited")
+
+		now = now.Add(time.Second)
+		allowed, _ = m.Allow(1)
+		assert.True(t, allowed)
+		allowed, started = m.Allow(1)
+		assert.False(t, allowed)
+		assert.True(t, started)
+	})
+
+	t.Run("mutes and unmutes the user", func(t *testing.T) {
+		m := newModeration(t)
+		assert.NoError(t, m.CheckMute(t.Context(), 1))
+
+		_, err := m.Mute(t.Context(), 1, time.Minute, "spam", "mage")
+		assert.NoError(t, err)
+
+		var muteErr *MuteError
+		if assert.ErrorAs(t, m.CheckMute(t.Context(), 1), &muteErr) {
+			assert.Equal(t, "spam", muteErr.Mute.Reason)
+		}
+		assert.NoError(t, m.CheckMute(t.Context(), 2))
+
+		unmuted, err := m.Unmute(t.Context(), 1, "mage")
+		assert.NoError(t, err)
+		assert.True(t, unmuted)
+		assert.NoError(t, m.CheckMute(t.Context(), 1))
+
+		unmuted, err = m.Unmute(t.Context(), 1, "mage")
+		assert.NoError(t, err)
+		assert.False(t, unmuted)
+
+		assert.Equal(t, []string{"unmute by mage", "mute by mage"}, auditLog(t, m))
+	})
+
+	t.Run("mute expires", func(t *testing.T) {
+		m := newModeration(t)
+		_, err := m.Mute(t.Context(), 1, time.Minute, "", "mage")
+		assert.NoError(t, err)
+
+		now = now.Add(time.Minute)
+		assert.NoError(t, m.CheckMute(t.Context(), 1))
+
+		_, err = m.Mute(t.Context(), 1, 0, "", "mage")
+		assert.Error(t, err)
+	})
+
+	t.Run("filters the messages", func(t *testing.T) {
+		m := newModeration(t)
+
+		text, err := m.Apply(t.Context(), 1, "DISPEL", "hello")
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", text)
+
+		text, err = m.Apply(t.Context(), 1, "DISPEL", "you troll")
+		assert.NoError(t, err)
+		assert.Equal(t, "you *****", text)
+
+		m.Filter.Mode = FilterReject
+		_, err = m.Apply(t.Context(), 1, "DISPEL", "you troll")
+		assert.ErrorIs(t, err, ErrChatFiltered)
+
+		assert.Equal(t, []string{"filter-reject by filter", "filter-mask by filter"}, auditLog(t, m))
+	})
+
+	t.Run("nil moderation allows everything", func(t *testing.T) {
+		var m *ChatModeration
+		allowed, _ := m.Allow(1)
+		assert.True(t, allowed)
+		assert.NoError(t, m.CheckMute(t.Context(), 1))
+		text, err := m.Apply(t.Context(), 1, "DISPEL", "troll")
+		assert.NoError(t, err)
+		assert.Equal(t, "troll", text)
+	})
+}
+
+func TestMultiplayer_ModerateChat(t *testing.T) {
+	mp := NewMultiplayer()
+	mp.Moderation = NewChatModeration(setupDatabase(t), FloodPolicy{}, WordFilter{Words: []string{"troll"}, Mode: FilterMask}, time.Now)
+
+	conn := &recordingConn{}
+	session := NewUserSession(1, conn)
+	session.User = wire.User{UserID: 1, Username: "archer"}
+	session.Channel = "DISPEL"
+
+	text, err := mp.moderateChat(t.Context(), session, "hi troll")
+	assert.NoError(t, err)
+	assert.Equal(t, "hi *****", text)
+	assert.Empty(t, conn.written)
+
+	_, err = mp.Moderation.Mute(t.Context(), 1, time.Hour, "spam", "mage")
+	assert.NoError(t, err)
+
+	_, err = mp.moderateChat(t.Context(), session, "hello")
+	var muteErr *MuteError
+	assert.ErrorAs(t, err, &muteErr)
+	if assert.Len(t, conn.written, 1) {
+		et, msg, err := wire.DecodeTyped[wire.ChatMessage](conn.written[0])
+		assert.NoError(t, err)
+		assert.Equal(t, wire.SystemMessage, et)
+		assert.Contains(t, msg.Content.Text, "Your message was dropped, you are muted until")
+		assert.Contains(t, msg.Content.Text, ": spam")
+	}
+}
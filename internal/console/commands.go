@@ -0,0 +1,423 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/wire"
+)
+
+// LobbyCommand is a chat command, e.g. "/who", which is handled by the server
+// instead of being sent to the other users of the lobby.
+type LobbyCommand struct {
+	// Name is the command typed after the slash, e.g. "who".
+	Name string
+
+	// Usage describes the arguments, e.g. "<name> [reason]".
+	Usage string
+
+	// Help is a short description listed by the "/help" command.
+	Help string
+
+	// Role is required to run the command. Everyone can run it when empty.
+	Role wire.Role
+
+	Run func(ctx context.Context, cmd *CommandContext) error
+}
+
+// CommandContext describes the command being run.
+type CommandContext struct {
+	Multiplayer *Multiplayer
+	Session     *UserSession
+	Command     *LobbyCommand
+
+	// Args is the text typed after the name of the command.
+	Args string
+}
+
+// Reply sends a system message to the user who has run the command.
+func (c *CommandContext) Reply(ctx context.Context, text string) {
+	c.Multiplayer.SendSystemMessage(ctx, c.Session, text)
+}
+
+// Usage returns the usage line of the command.
+func (c *CommandContext) Usage() string {
+	return strings.TrimSpace("Usage: /" + c.Command.Name + " " + c.Command.Usage)
+}
+
+// CommandRouter dispatches the chat commands to the registered handlers.
+type CommandRouter struct {
+	mu       sync.RWMutex
+	commands map[string]*LobbyCommand
+}
+
+func NewCommandRouter() *CommandRouter {
+	return &CommandRouter{commands: make(map[string]*LobbyCommand)}
+}
+
+// Register adds the command to the router. The names are not case-sensitive.
+func (r *CommandRouter) Register(cmd LobbyCommand) error {
+	name := strings.ToLower(cmd.Name)
+	if name == "" || strings.ContainsAny(name, " /") {
+		return fmt.Errorf("invalid command name %q", cmd.Name)
+	}
+	if cmd.Run == nil {
+		return fmt.Errorf("command %q has no handler", cmd.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.commands[name]; exists {
+		return fmt.Errorf("command %q is already registered", cmd.Name)
+	}
+	cmd.Name = name
+	r.commands[name] = &cmd
+	return nil
+}
+
+// Commands lists the commands available to the role, ordered by name.
+func (r *CommandRouter) Commands(role wire.Role) []LobbyCommand {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]LobbyCommand, 0, len(r.commands))
+	for _, cmd := range r.commands {
+		if role.Includes(cmd.Role) {
+			list = append(list, *cmd)
+		}
+	}
+	slices.SortFunc(list, func(a, b LobbyCommand) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return list
+}
+
+// Handle runs the command when the text starts with a slash and reports
+// whether the text has been handled as a command.
+func (r *CommandRouter) Handle(ctx context.Context, mp *Multiplayer, session *UserSession, text string) bool {
+	line, ok := strings.CutPrefix(text, "/")
+	if !ok {
+		return false
+	}
+	name, args, _ := strings.Cut(strings.TrimSpace(line), " ")
+	name = strings.ToLower(name)
+
+	r.mu.RLock()
+	cmd, found := r.commands[name]
+	r.mu.RUnlock()
+
+	cc := &CommandContext{
+		Multiplayer: mp,
+		Session:     session,
+		Command:     cmd,
+		Args:        strings.TrimSpace(args),
+	}
+	if !found || !session.HasRole(cmd.Role) {
+		cc.Reply(ctx, fmt.Sprintf("Unknown command /%s, type /help to list the commands", name))
+		return true
+	}
+	if err := cmd.Run(ctx, cc); err != nil {
+		cc.Reply(ctx, fmt.Sprintf("/%s failed: %s", name, err))
+	}
+	return true
+}
+
+// defaultCommands are registered in every lobby.
+func defaultCommands() []LobbyCommand {
+	return []LobbyCommand{
+		{
+			Name: "help",
+			Help: "Lists the commands",
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				for _, c := range cmd.Multiplayer.Commands.Commands(cmd.Session.User.Role) {
+					line := "/" + c.Name
+					if c.Usage != "" {
+						line += " " + c.Usage
+					}
+					cmd.Reply(ctx, line+" - "+c.Help)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "who",
+			Help: "Lists the players in the channel",
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				players := cmd.Multiplayer.listChannelSessions(cmd.Session.Channel)
+				names := make([]string, len(players))
+				for i, player := range players {
+					names[i] = player.Username
+				}
+				slices.Sort(names)
+				cmd.Reply(ctx, fmt.Sprintf("%d in %s: %s", len(names), cmd.Session.Channel, strings.Join(names, ", ")))
+				return nil
+			},
+		},
+		{
+			Name: "rooms",
+			Help: "Lists the game rooms",
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				mp := cmd.Multiplayer
+				mp.roomsMutex.RLock()
+				rooms := make([]string, 0, len(mp.Rooms))
+				for _, room := range mp.Rooms {
+					rooms = append(rooms, fmt.Sprintf("%s - %d players, %s", room.Name, len(room.Players), room.MapID))
+				}
+				mp.roomsMutex.RUnlock()
+
+				if len(rooms) == 0 {
+					cmd.Reply(ctx, "There are no game rooms")
+					return nil
+				}
+				slices.Sort(rooms)
+				for _, room := range rooms {
+					cmd.Reply(ctx, room)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "ping",
+			Help: "Checks the connection with the server",
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				cmd.Reply(ctx, "Pong, server time is "+time.Now().UTC().Format(time.TimeOnly))
+				return nil
+			},
+		},
+		{
+			Name:  "me",
+			Usage: "<action>",
+			Help:  "Describes what you are doing",
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				if cmd.Args == "" {
+					cmd.Reply(ctx, cmd.Usage())
+					return nil
+				}
+				action, err := cmd.Multiplayer.moderateChat(ctx, cmd.Session, cmd.Args)
+				if err != nil {
+					// The user has already been told why it was dropped.
+					return nil
+				}
+				cmd.Multiplayer.BroadcastChannelMessage(ctx, cmd.Session.Channel, wire.ComposeTyped(wire.Chat, wire.MessageContent[wire.ChatMessage]{
+					From:    fmt.Sprint(cmd.Session.UserID),
+					Type:    wire.Chat,
+					Content: wire.ChatMessage{User: "*", Text: cmd.Session.User.Username + " " + action},
+				}))
+				return nil
+			},
+		},
+		{
+			Name: "motd",
+			Help: "Shows the message of the day",
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				motd := cmd.Multiplayer.ChannelMOTD(ctx, cmd.Session.Channel)
+				if motd == "" {
+					cmd.Reply(ctx, "There is no message of the day")
+					return nil
+				}
+				cmd.Reply(ctx, motd)
+				return nil
+			},
+		},
+		{
+			Name:  "w",
+			Usage: "<name> <text>",
+			Help:  "Sends a private message",
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				username, text, _ := strings.Cut(cmd.Args, " ")
+				if username == "" || strings.TrimSpace(text) == "" {
+					cmd.Reply(ctx, cmd.Usage())
+					return nil
+				}
+				_ = cmd.Multiplayer.SendPrivateMessage(ctx, cmd.Session, wire.Whisper{To: username, Text: strings.TrimSpace(text)})
+				return nil
+			},
+		},
+		{
+			Name:  "friends",
+			Usage: "[add|remove <name>]",
+			Help:  "Lists your friends, adds or removes a friend",
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				friends := cmd.Multiplayer.Friends
+				if friends == nil {
+					return fmt.Errorf("friends are disabled")
+				}
+				action, username, _ := strings.Cut(cmd.Args, " ")
+				username = strings.TrimSpace(username)
+
+				switch strings.ToLower(action) {
+				case "":
+					list, err := friends.List(ctx, cmd.Session.UserID)
+					if err != nil {
+						return err
+					}
+					if len(list) == 0 {
+						cmd.Reply(ctx, "You have no friends yet, type /friends add <name>")
+						return nil
+					}
+					for _, friend := range list {
+						var status string
+						switch {
+						case !friend.Accepted && friend.RequestedBy == cmd.Session.UserID:
+							status = "waiting for the answer"
+						case !friend.Accepted:
+							status = "wants to be your friend"
+						default:
+							status = "offline"
+							if presence, found := cmd.Multiplayer.GetUserPresence(friend.ID); found {
+								status = "online in " + presence.Channel
+							}
+						}
+						cmd.Reply(ctx, friend.Username+" - "+status)
+					}
+					return nil
+				case "add":
+					if username == "" {
+						break
+					}
+					friend, err := friends.DB.Read.GetUserByName(ctx, username)
+					if err != nil {
+						return fmt.Errorf("user %s not found", username)
+					}
+					accepted, changed, err := friends.Add(ctx, cmd.Session.UserID, friend.ID)
+					if err != nil {
+						return err
+					}
+					if changed {
+						notifyFriendRequest(ctx, cmd.Multiplayer, cmd.Session.User.Username, friend.ID, accepted)
+					}
+					if accepted {
+						cmd.Reply(ctx, friend.Username+" is now your friend")
+					} else {
+						cmd.Reply(ctx, "Asked "+friend.Username+" to be your friend")
+					}
+					return nil
+				case "remove":
+					if username == "" {
+						break
+					}
+					friend, err := friends.DB.Read.GetUserByName(ctx, username)
+					if err != nil {
+						return fmt.Errorf("user %s not found", username)
+					}
+					if err := friends.Remove(ctx, cmd.Session.UserID, friend.ID); err != nil {
+						return err
+					}
+					cmd.Reply(ctx, "Removed "+friend.Username+" from your friends")
+					return nil
+				}
+				cmd.Reply(ctx, cmd.Usage())
+				return nil
+			},
+		},
+		{
+			Name:  "kick",
+			Usage: "<name> [reason]",
+			Help:  "Disconnects the player from the lobby",
+			Role:  wire.RoleModerator,
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				username, reason, _ := strings.Cut(cmd.Args, " ")
+				if username == "" {
+					cmd.Reply(ctx, cmd.Usage())
+					return nil
+				}
+				target, found := cmd.Multiplayer.GetUserSessionByName(username)
+				if !found {
+					return fmt.Errorf("%s is not online", username)
+				}
+				reason = strings.TrimSpace(reason)
+				if reason == "" {
+					reason = "kicked by " + cmd.Session.User.Username
+				}
+				if err := cmd.Multiplayer.KickUser(target.UserID, reason); err != nil {
+					return err
+				}
+				cmd.Reply(ctx, "Kicked "+target.User.Username)
+				return nil
+			},
+		},
+		{
+			Name:  "mute",
+			Usage: "<name> <duration> [reason]",
+			Help:  "Prevents the player from chatting, e.g. /mute archer 10m spam",
+			Role:  wire.RoleModerator,
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				fields := strings.SplitN(cmd.Args, " ", 3)
+				if len(fields) < 2 {
+					cmd.Reply(ctx, cmd.Usage())
+					return nil
+				}
+				duration, err := time.ParseDuration(fields[1])
+				if err != nil {
+					cmd.Reply(ctx, cmd.Usage())
+					return nil
+				}
+				var reason string
+				if len(fields) == 3 {
+					reason = strings.TrimSpace(fields[2])
+				}
+				target, found := cmd.Multiplayer.GetUserSessionByName(fields[0])
+				if !found {
+					return fmt.Errorf("%s is not online", fields[0])
+				}
+				if cmd.Multiplayer.Moderation == nil {
+					return fmt.Errorf("chat moderation is disabled")
+				}
+				mute, err := cmd.Multiplayer.Moderation.Mute(ctx, target.UserID, duration, reason, cmd.Session.User.Username)
+				if err != nil {
+					return err
+				}
+				cmd.Multiplayer.SendSystemMessage(ctx, target, (&MuteError{Mute: mute}).Error())
+				cmd.Reply(ctx, fmt.Sprintf("Muted %s for %s", target.User.Username, duration))
+				return nil
+			},
+		},
+		{
+			Name:  "unmute",
+			Usage: "<name>",
+			Help:  "Allows the muted player to chat again",
+			Role:  wire.RoleModerator,
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				if cmd.Args == "" {
+					cmd.Reply(ctx, cmd.Usage())
+					return nil
+				}
+				target, found := cmd.Multiplayer.GetUserSessionByName(cmd.Args)
+				if !found {
+					return fmt.Errorf("%s is not online", cmd.Args)
+				}
+				if cmd.Multiplayer.Moderation == nil {
+					return fmt.Errorf("chat moderation is disabled")
+				}
+				unmuted, err := cmd.Multiplayer.Moderation.Unmute(ctx, target.UserID, cmd.Session.User.Username)
+				if err != nil {
+					return err
+				}
+				if !unmuted {
+					return fmt.Errorf("%s is not muted", target.User.Username)
+				}
+				cmd.Multiplayer.SendSystemMessage(ctx, target, "You can chat again")
+				cmd.Reply(ctx, "Unmuted "+target.User.Username)
+				return nil
+			},
+		},
+		{
+			Name:  "announce",
+			Usage: "<text>",
+			Help:  "Sends a system message to everyone",
+			Role:  wire.RoleModerator,
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				if cmd.Args == "" {
+					cmd.Reply(ctx, cmd.Usage())
+					return nil
+				}
+				cmd.Multiplayer.BroadcastSystemMessage(ctx, cmd.Args)
+				return nil
+			},
+		},
+	}
+}
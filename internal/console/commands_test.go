@@ -0,0 +1,132 @@
+package console
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandRouter(t *testing.T) {
+	newSession := func(mp *Multiplayer, userID int64, username string, role wire.Role) (*UserSession, *recordingConn) {
+		conn := &recordingConn{}
+		session := NewUserSession(userID, conn)
+		session.User = wire.User{UserID: userID, Username: username, Role: role}
+		session.Channel = "DISPEL"
+		mp.AddUserSession(userID, session)
+		return session, conn
+	}
+	replies := func(t *testing.T, conn *recordingConn) []string {
+		t.Helper()
+		conn.mu.Lock()
+		defer conn.mu.Unlock()
+		var texts []string
+		for _, payload := range conn.written {
+			et, msg, err := wire.DecodeTyped[wire.ChatMessage](payload)
+			assert.NoError(t, err)
+			assert.Equal(t, wire.SystemMessage, et)
+			texts = append(texts, msg.Content.Text)
+		}
+		conn.written = nil
+		return texts
+	}
+
+	t.Run("plain text is not a command", func(t *testing.T) {
+		mp := NewMultiplayer()
+		session, conn := newSession(mp, 1, "archer", wire.RolePlayer)
+
+		assert.False(t, mp.Commands.Handle(t.Context(), mp, session, "hello /who"))
+		assert.Empty(t, replies(t, conn))
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		mp := NewMultiplayer()
+		session, conn := newSession(mp, 1, "archer", wire.RolePlayer)
+
+		assert.True(t, mp.Commands.Handle(t.Context(), mp, session, "/dance"))
+		assert.Equal(t, []string{"Unknown command /dance, type /help to list the commands"}, replies(t, conn))
+	})
+
+	t.Run("who lists the players of the channel", func(t *testing.T) {
+		mp := NewMultiplayer()
+		session, conn := newSession(mp, 1, "archer", wire.RolePlayer)
+		newSession(mp, 2, "mage", wire.RolePlayer)
+		other, _ := newSession(mp, 3, "knight", wire.RolePlayer)
+		other.Channel = "PVP"
+
+		assert.True(t, mp.Commands.Handle(t.Context(), mp, session, "/WHO"))
+		assert.Equal(t, []string{"2 in DISPEL: archer, mage"}, replies(t, conn))
+	})
+
+	t.Run("moderator commands", func(t *testing.T) {
+		mp := NewMultiplayer()
+		player, playerConn := newSession(mp, 1, "archer", wire.RolePlayer)
+		moderator, moderatorConn := newSession(mp, 2, "mage", wire.RoleModerator)
+
+		assert.True(t, mp.Commands.Handle(t.Context(), mp, player, "/kick mage"))
+		assert.Equal(t, []string{"Unknown command /kick, type /help to list the commands"}, replies(t, playerConn))
+		assert.Equal(t, websocket.StatusCode(0), moderatorConn.closeCode)
+
+		assert.True(t, mp.Commands.Handle(t.Context(), mp, moderator, "/kick Archer spamming"))
+		assert.Equal(t, []string{"Kicked archer"}, replies(t, moderatorConn))
+		assert.Equal(t, websocket.StatusPolicyViolation, playerConn.closeCode)
+		assert.Equal(t, "spamming", playerConn.closeReason)
+	})
+
+	t.Run("moderator mutes the player", func(t *testing.T) {
+		mp := NewMultiplayer()
+		mp.Moderation = NewChatModeration(setupDatabase(t), FloodPolicy{}, WordFilter{}, time.Now)
+		player, playerConn := newSession(mp, 1, "archer", wire.RolePlayer)
+		moderator, moderatorConn := newSession(mp, 2, "mage", wire.RoleModerator)
+
+		assert.True(t, mp.Commands.Handle(t.Context(), mp, moderator, "/mute archer soon"))
+		assert.Equal(t, []string{"Usage: /mute <name> <duration> [reason]"}, replies(t, moderatorConn))
+
+		assert.True(t, mp.Commands.Handle(t.Context(), mp, moderator, "/mute archer 10m spamming"))
+		assert.Equal(t, []string{"Muted archer for 10m0s"}, replies(t, moderatorConn))
+		assert.Len(t, replies(t, playerConn), 1)
+		assert.Error(t, mp.Moderation.CheckMute(t.Context(), player.UserID))
+
+		assert.True(t, mp.Commands.Handle(t.Context(), mp, moderator, "/unmute archer"))
+		assert.Equal(t, []string{"Unmuted archer"}, replies(t, moderatorConn))
+		assert.Equal(t, []string{"You can chat again"}, replies(t, playerConn))
+		assert.NoError(t, mp.Moderation.CheckMute(t.Context(), player.UserID))
+	})
+
+	t.Run("help lists the commands available to the role", func(t *testing.T) {
+		mp := NewMultiplayer()
+		player, playerConn := newSession(mp, 1, "archer", wire.RolePlayer)
+		moderator, moderatorConn := newSession(mp, 2, "mage", wire.RoleModerator)
+
+		mp.Commands.Handle(t.Context(), mp, player, "/help")
+		help := replies(t, playerConn)
+		assert.Contains(t, help, "/who - Lists the players in the channel")
+		assert.NotContains(t, help, "/kick <name> [reason] - Disconnects the player from the lobby")
+
+		mp.Commands.Handle(t.Context(), mp, moderator, "/help")
+		assert.Contains(t, replies(t, moderatorConn), "/kick <name> [reason] - Disconnects the player from the lobby")
+	})
+
+	t.Run("register custom command", func(t *testing.T) {
+		mp := NewMultiplayer()
+		session, conn := newSession(mp, 1, "archer", wire.RolePlayer)
+
+		assert.NoError(t, mp.Commands.Register(LobbyCommand{
+			Name:  "Rules",
+			Usage: "[topic]",
+			Help:  "Shows the rules",
+			Run: func(ctx context.Context, cmd *CommandContext) error {
+				cmd.Reply(ctx, "Be nice: "+cmd.Args)
+				return nil
+			},
+		}))
+		assert.Error(t, mp.Commands.Register(LobbyCommand{Name: "rules", Run: func(context.Context, *CommandContext) error { return nil }}))
+		assert.Error(t, mp.Commands.Register(LobbyCommand{Name: "no handler"}))
+
+		assert.True(t, mp.Commands.Handle(t.Context(), mp, session, "/rules  chat "))
+		assert.Equal(t, []string{"Be nice: chat"}, replies(t, conn))
+	})
+}
@@ -2,17 +2,23 @@ package console
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/tls"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
+	"github.com/dimspell/gladiator/internal/console/auth"
 	"github.com/dimspell/gladiator/internal/console/database"
 	"github.com/dimspell/gladiator/internal/metrics"
 	"github.com/dimspell/gladiator/internal/model"
@@ -20,6 +26,7 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
+	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
@@ -34,6 +41,18 @@ type Console struct {
 	DB          *database.SQLite
 	Multiplayer *Multiplayer
 	Relay       *Relay
+	Sessions    *auth.SessionSigner
+	Bans        *BanList
+
+	// Announcements are the system messages broadcast on schedule.
+	Announcements *AnnouncementList
+
+	// TLS is the certificate of the console HTTP server. The server speaks
+	// plain HTTP (h2c) when it is nil.
+	TLS *CertReloader
+
+	// RelayTLS is the certificate of the QUIC relay server.
+	RelayTLS *CertReloader
 }
 
 func NewConsole(db *database.SQLite, opts ...Option) *Console {
@@ -44,12 +63,52 @@ func NewConsole(db *database.SQLite, opts ...Option) *Console {
 		}
 	}
 
+	if len(config.SessionSecret) == 0 {
+		slog.Warn("Session secret is not configured, the game rooms will not be restored after a restart")
+		config.SessionSecret = randomSecret()
+	}
+
 	multiplayer := NewMultiplayer()
+	now := multiplayer.now
+	multiplayer.Channels = NewChannelList(db)
+	multiplayer.MOTD = config.MOTD
+	multiplayer.History = NewChatHistory(db, config.ChatReplay, config.ChatHistoryLimit, config.ChatHistoryTTL, now)
+	multiplayer.Moderation = NewChatModeration(db, config.ChatFlood, config.ChatFilter, now)
+	multiplayer.Friends = NewFriendList(db, now)
+	multiplayer.RoomStore = NewRoomStore(db, now)
+	multiplayer.Reaper = config.RoomReaper
+	multiplayer.KickBan = config.RoomKickBan
+	multiplayer.Matches = NewMatchHistory(db, now)
+	multiplayer.OnRoomStateChange(multiplayer.Matches.Observe)
+	sessions := auth.NewSessionSigner(config.SessionSecret, config.SessionTTL, now)
+	bans := NewBanList(db, now)
+
+	var consoleTLS *CertReloader
+	var err error
+	if config.TLSCertFile != "" {
+		consoleTLS, err = NewCertReloader(config.TLSCertFile, config.TLSKeyFile)
+		if err != nil {
+			panic("failed to load console certificate: " + err.Error())
+		}
+	}
 
 	var relay *Relay
-	var err error
+	var relayTLS *CertReloader
 	if config.RunMode == model.RunModeRelay {
-		relay, err = NewRelay(config.RelayBindAddr, multiplayer)
+		switch {
+		case config.RelayTLSCertFile != "":
+			relayTLS, err = NewCertReloader(config.RelayTLSCertFile, config.RelayTLSKeyFile)
+			if err != nil {
+				panic("failed to load relay certificate: " + err.Error())
+			}
+		case consoleTLS != nil:
+			relayTLS = consoleTLS
+		default:
+			slog.Warn("Relay server uses the development certificate, configure a real one for production")
+			relayTLS = newStaticCertReloader(generateSelfSigned())
+		}
+
+		relay, err = NewRelay(config.RelayBindAddr, multiplayer, sessions, bans, relayTLS)
 		if err != nil {
 			panic("failed to initialize relay: " + err.Error())
 		}
@@ -61,7 +120,13 @@ func NewConsole(db *database.SQLite, opts ...Option) *Console {
 		DB:          db,
 		Multiplayer: multiplayer,
 		Relay:       relay,
+		Sessions:    sessions,
+		Bans:        bans,
+		TLS:         consoleTLS,
+		RelayTLS:    relayTLS,
 		Config:      config,
+
+		Announcements: NewAnnouncementList(db, multiplayer),
 	}
 }
 
@@ -75,6 +140,64 @@ type Config struct {
 	RelayPublicAddr    string
 	CORSAllowedOrigins []string
 	Version            string
+
+	// SessionSecret is the key used to sign the session tokens. When it is
+	// not configured, a random one is generated on every start, which
+	// invalidates all previously issued tokens. The backends cannot sign in
+	// again after a restart then, and the restored game rooms are dropped.
+	SessionSecret []byte
+	SessionTTL    time.Duration
+
+	// PasswordCost is the bcrypt cost of the stored passwords. Passwords
+	// hashed with a different cost are re-hashed on the next sign-in.
+	PasswordCost int
+
+	// AdminSecret is the bearer token granting the admin role in the RPCs,
+	// next to the session tokens of the users with the admin role. It is not
+	// accepted when empty.
+	AdminSecret      []byte
+	PasswordResetTTL time.Duration
+
+	// TLSCertFile and TLSKeyFile are the PEM files with the certificate of the
+	// console HTTP server. The relay server uses it too, unless it has its own
+	// certificate configured in RelayTLSCertFile and RelayTLSKeyFile.
+	TLSCertFile      string
+	TLSKeyFile       string
+	RelayTLSCertFile string
+	RelayTLSKeyFile  string
+
+	// UsernamePolicy and CharacterNamePolicy restrict the names of the newly
+	// registered users and characters.
+	UsernamePolicy      NamePolicy
+	CharacterNamePolicy NamePolicy
+
+	// MOTD is the message of the day shown to the users entering a channel,
+	// which has no message on its own, and by the "/motd" chat command.
+	MOTD string
+
+	// ChatReplay is the number of the recent chat messages sent to the user
+	// entering a channel. ChatHistoryLimit and ChatHistoryTTL limit how many
+	// messages are stored per channel and for how long.
+	ChatReplay       int
+	ChatHistoryLimit int
+	ChatHistoryTTL   time.Duration
+
+	// ChatFlood limits how many chat messages a user can send in a row.
+	ChatFlood FloodPolicy
+
+	// ChatFilter masks or rejects the chat messages with forbidden words.
+	ChatFilter WordFilter
+
+	// RoomRestoreGrace is how long the hosts of the game rooms restored after
+	// the restart have to reconnect, before their rooms are dropped.
+	RoomRestoreGrace time.Duration
+
+	// RoomReaper closes the abandoned game rooms.
+	RoomReaper RoomReaperPolicy
+
+	// RoomKickBan is how long the player kicked by the host cannot join the
+	// game room again.
+	RoomKickBan time.Duration
 }
 
 func DefaultConfig() *Config {
@@ -86,9 +209,40 @@ func DefaultConfig() *Config {
 		RelayPublicAddr:    "localhost:9999",
 		CORSAllowedOrigins: []string{"*"},
 		Version:            "dev",
+		SessionTTL:         24 * time.Hour,
+		PasswordCost:       auth.DefaultPasswordCost,
+		PasswordResetTTL:   time.Hour,
+		UsernamePolicy: NamePolicy{
+			MinLength:      2,
+			MaxLength:      8,
+			AllowedSymbols: "-_",
+			Reserved:       DefaultReservedNames,
+		},
+		CharacterNamePolicy: NamePolicy{
+			MinLength:      2,
+			MaxLength:      16,
+			AllowedSymbols: "-_",
+			Reserved:       DefaultReservedNames,
+		},
+		ChatReplay:       20,
+		ChatHistoryLimit: 1000,
+		ChatHistoryTTL:   7 * 24 * time.Hour,
+		ChatFlood:        FloodPolicy{Burst: 5, Interval: time.Second},
+		ChatFilter:       WordFilter{Mode: FilterMask},
+		RoomRestoreGrace: 2 * time.Minute,
+		RoomReaper:       DefaultRoomReaperPolicy,
+		RoomKickBan:      10 * time.Minute,
 	}
 }
 
+func randomSecret() []byte {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic("failed to generate session secret: " + err.Error())
+	}
+	return secret
+}
+
 // TODO: For production replace it with []string{"https://dispel-multi.net"}
 func WithCORSAllowedOrigins(allowedOrigins []string) Option {
 	return func(c *Config) error {
@@ -121,6 +275,170 @@ func WithVersion(version string) Option {
 	}
 }
 
+func WithSessionSecret(secret string) Option {
+	return func(c *Config) error {
+		if len(secret) < 16 {
+			return fmt.Errorf("session secret must be at least 16 characters long")
+		}
+		c.SessionSecret = []byte(secret)
+		return nil
+	}
+}
+
+func WithPasswordCost(cost int) Option {
+	return func(c *Config) error {
+		if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+			return fmt.Errorf("password cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+		c.PasswordCost = cost
+		return nil
+	}
+}
+
+func WithAdminSecret(secret string) Option {
+	return func(c *Config) error {
+		if len(secret) < 16 {
+			return fmt.Errorf("admin secret must be at least 16 characters long")
+		}
+		c.AdminSecret = []byte(secret)
+		return nil
+	}
+}
+
+func WithTLS(certFile, keyFile string) Option {
+	return func(c *Config) error {
+		if certFile == "" || keyFile == "" {
+			return fmt.Errorf("both the certificate and the key file must be provided")
+		}
+		c.TLSCertFile = certFile
+		c.TLSKeyFile = keyFile
+		return nil
+	}
+}
+
+func WithRelayTLS(certFile, keyFile string) Option {
+	return func(c *Config) error {
+		if certFile == "" || keyFile == "" {
+			return fmt.Errorf("both the relay certificate and the key file must be provided")
+		}
+		c.RelayTLSCertFile = certFile
+		c.RelayTLSKeyFile = keyFile
+		return nil
+	}
+}
+
+// WithReservedNames reserves the names in addition to DefaultReservedNames.
+func WithReservedNames(names []string) Option {
+	return func(c *Config) error {
+		c.UsernamePolicy.Reserved = append(slices.Clone(c.UsernamePolicy.Reserved), names...)
+		c.CharacterNamePolicy.Reserved = append(slices.Clone(c.CharacterNamePolicy.Reserved), names...)
+		return nil
+	}
+}
+
+// WithNameDenyList loads the words, which cannot be used in the user and
+// character names, from the file.
+func WithNameDenyList(path string) Option {
+	return func(c *Config) error {
+		words, err := LoadDenyList(path)
+		if err != nil {
+			return err
+		}
+		c.UsernamePolicy.Denied = append(slices.Clone(c.UsernamePolicy.Denied), words...)
+		c.CharacterNamePolicy.Denied = append(slices.Clone(c.CharacterNamePolicy.Denied), words...)
+		return nil
+	}
+}
+
+func WithMOTD(motd string) Option {
+	return func(c *Config) error {
+		c.MOTD = motd
+		return nil
+	}
+}
+
+// WithChatHistory configures how many chat messages are replayed to the users
+// entering a channel and how long the messages are stored. Zero limit or TTL
+// means the messages are kept forever.
+func WithChatHistory(replay, limit int, ttl time.Duration) Option {
+	return func(c *Config) error {
+		if replay < 0 || limit < 0 || ttl < 0 {
+			return fmt.Errorf("chat history settings cannot be negative")
+		}
+		c.ChatReplay = replay
+		c.ChatHistoryLimit = limit
+		c.ChatHistoryTTL = ttl
+		return nil
+	}
+}
+
+// WithChatFlood limits the chat messages to the burst of messages, refilled
+// with one message every interval. Zero burst disables the limit.
+func WithChatFlood(burst int, interval time.Duration) Option {
+	return func(c *Config) error {
+		if burst > 0 && interval <= 0 {
+			return fmt.Errorf("chat flood interval must be positive")
+		}
+		c.ChatFlood = FloodPolicy{Burst: burst, Interval: interval}
+		return nil
+	}
+}
+
+// WithChatFilter loads the words forbidden in the chat from the file, one per
+// line. The mode is either "mask" or "reject".
+func WithChatFilter(path string, mode FilterMode) Option {
+	return func(c *Config) error {
+		if mode != FilterMask && mode != FilterReject {
+			return fmt.Errorf("unknown chat filter mode: %q", mode)
+		}
+		words, err := LoadDenyList(path)
+		if err != nil {
+			return err
+		}
+		c.ChatFilter = WordFilter{Words: words, Mode: mode}
+		return nil
+	}
+}
+
+// WithRoomRestoreGrace configures how long the players have to reconnect to the
+// game rooms restored after the restart of the console.
+func WithRoomRestoreGrace(grace time.Duration) Option {
+	return func(c *Config) error {
+		if grace < 0 {
+			return fmt.Errorf("room restore grace period cannot be negative")
+		}
+		c.RoomRestoreGrace = grace
+		return nil
+	}
+}
+
+// WithRoomReaper configures after how long the game rooms, which have not been
+// started, which host has disconnected or which nobody has joined or left, are
+// closed. A zero timeout disables the check.
+func WithRoomReaper(notReady, hostLost, idle time.Duration) Option {
+	return func(c *Config) error {
+		if notReady < 0 || hostLost < 0 || idle < 0 {
+			return fmt.Errorf("room timeouts cannot be negative")
+		}
+		c.RoomReaper.NotReady = notReady
+		c.RoomReaper.HostLost = hostLost
+		c.RoomReaper.Idle = idle
+		return nil
+	}
+}
+
+// WithRoomKickBan configures how long the player kicked by the host cannot
+// join the game room again. A zero period lets them join again at once.
+func WithRoomKickBan(ban time.Duration) Option {
+	return func(c *Config) error {
+		if ban < 0 {
+			return fmt.Errorf("room kick ban cannot be negative")
+		}
+		c.RoomKickBan = ban
+		return nil
+	}
+}
+
 func (c *Console) HttpRouter() http.Handler {
 	mux := chi.NewRouter()
 
@@ -174,6 +492,7 @@ func (c *Console) HttpRouter() http.Handler {
 				http.MethodPost,
 			},
 			AllowedHeaders: []string{
+				"Authorization",
 				"Content-Type",
 				"Connect-Protocol-Version",
 				"Connect-Timeout-Ms",
@@ -189,10 +508,46 @@ func (c *Console) HttpRouter() http.Handler {
 			MaxAge: 7200,
 		}).Handler)
 
-		api.Mount(multiv1connect.NewCharacterServiceHandler(&characterServiceServer{c.DB}))
-		api.Mount(multiv1connect.NewGameServiceHandler(&gameServiceServer{Multiplayer: c.Multiplayer}))
-		api.Mount(multiv1connect.NewUserServiceHandler(&userServiceServer{c.DB}))
-		api.Mount(multiv1connect.NewRankingServiceHandler(&rankingServiceServer{c.DB}))
+		authorized := connect.WithInterceptors(NewAuthInterceptor(c.Sessions))
+		roles := &roleAuthorizer{
+			AdminSecret: c.Config.AdminSecret,
+			Sessions:    c.Sessions,
+			DB:          c.DB,
+		}
+
+		api.Mount(multiv1connect.NewCharacterServiceHandler(&characterServiceServer{
+			DB:      c.DB,
+			Names:   &c.Config.CharacterNamePolicy,
+			Matches: c.Multiplayer.Matches,
+		}, authorized))
+		api.Mount(multiv1connect.NewGameServiceHandler(&gameServiceServer{Multiplayer: c.Multiplayer}, authorized))
+		api.Mount(multiv1connect.NewUserServiceHandler(&userServiceServer{
+			DB:               c.DB,
+			Sessions:         c.Sessions,
+			Throttle:         newLoginThrottle(c.DB, c.Multiplayer.now),
+			PasswordCost:     c.Config.PasswordCost,
+			PasswordResetTTL: c.Config.PasswordResetTTL,
+			Bans:             c.Bans,
+			Roles:            roles,
+			Names:            &c.Config.UsernamePolicy,
+		}))
+		api.Mount(multiv1connect.NewRankingServiceHandler(&rankingServiceServer{c.DB}, authorized))
+		api.Mount(multiv1connect.NewMatchServiceHandler(&matchServiceServer{c.DB}))
+		api.Mount(multiv1connect.NewChannelServiceHandler(&channelServiceServer{
+			Channels:    c.Multiplayer.Channels,
+			Multiplayer: c.Multiplayer,
+		}, authorized))
+		api.Mount(multiv1connect.NewSocialServiceHandler(&socialServiceServer{
+			DB:          c.DB,
+			Friends:     c.Multiplayer.Friends,
+			Multiplayer: c.Multiplayer,
+		}, authorized))
+		api.Mount(multiv1connect.NewAdminServiceHandler(&adminServiceServer{
+			DB:            c.DB,
+			Multiplayer:   c.Multiplayer,
+			Bans:          c.Bans,
+			Announcements: c.Announcements,
+		}, connect.WithInterceptors(NewAdminInterceptor(roles))))
 		mux.Mount("/grpc/", http.StripPrefix("/grpc", api))
 	}
 
@@ -208,18 +563,43 @@ func (c *Console) HttpRouter() http.Handler {
 }
 
 func (c *Console) Handlers() (start GracefulFunc, shutdown GracefulFunc) {
+	router := c.HttpRouter()
 	httpServer := &http.Server{
 		Addr:         c.Config.ConsoleBindAddr,
-		Handler:      h2c.NewHandler(c.HttpRouter(), &http2.Server{}),
+		Handler:      h2c.NewHandler(router, &http2.Server{}),
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  120 * time.Second,
 	}
+	if c.TLS != nil {
+		// HTTP/2 is negotiated with ALPN, there is no need for h2c.
+		httpServer.Handler = router
+		httpServer.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: c.TLS.GetCertificate,
+		}
+	}
 
 	start = func(ctx context.Context) error {
 		slog.Info("Configured console server", "addr", c.Config.ConsoleBindAddr)
 
+		reloaders := []*CertReloader{c.TLS}
+		if c.RelayTLS != c.TLS {
+			reloaders = append(reloaders, c.RelayTLS)
+		}
+		go reloadOnSignal(ctx, reloaders...)
+
+		restored, err := c.Multiplayer.RestoreRooms(ctx)
+		if err != nil {
+			slog.Warn("Could not restore the game rooms", logging.Error(err))
+		}
+		if restored > 0 {
+			slog.Info("Restored the game rooms, waiting for the players to reconnect", "rooms", restored, "grace", c.Config.RoomRestoreGrace)
+			go c.Multiplayer.reconcileRoomsAfter(ctx, c.Config.RoomRestoreGrace)
+		}
+
 		go c.Multiplayer.Run(ctx)
+		go c.Announcements.Run(ctx)
 		go c.Relay.Start(ctx)
 
 		// TODO: Move it elsewhere
@@ -233,6 +613,12 @@ func (c *Console) Handlers() (start GracefulFunc, shutdown GracefulFunc) {
 			}()
 		}
 
+		if c.TLS != nil {
+			if err := http2.ConfigureServer(httpServer, &http2.Server{}); err != nil {
+				return err
+			}
+			return httpServer.ListenAndServeTLS("", "")
+		}
 		return httpServer.ListenAndServe()
 	}
 
@@ -302,6 +688,9 @@ func (c *Console) WellKnownInfo() http.HandlerFunc {
 		switch c.Config.RunMode {
 		case model.RunModeRelay:
 			wk.RelayServerAddr = c.Config.RelayPublicAddr
+			if c.RelayTLS != nil {
+				wk.RelayServerFingerprint = c.RelayTLS.Fingerprint()
+			}
 		case model.RunModeLAN:
 			wk.CallerIP = getCallerIP(r.RemoteAddr)
 		}
@@ -112,6 +112,8 @@ func TestConsole_Handlers(t *testing.T) {
 			assert.Equal(t, wellKnown.Addr, "https://console.example.com")
 			assert.Equal(t, wellKnown.RunMode, model.RunModeRelay)
 			assert.Equal(t, wellKnown.RelayServerAddr, "relay.example.com:9123")
+			assert.NotEmpty(t, wellKnown.RelayServerFingerprint)
+			assert.Equal(t, wellKnown.RelayServerFingerprint, c.RelayTLS.Fingerprint())
 			assert.Equal(t, wellKnown.CallerIP, "")
 		})
 
@@ -154,21 +156,92 @@ func TestConsole_Handlers(t *testing.T) {
 	})
 
 	t.Run("Connect to websocket", func(t *testing.T) {
-		c := &Console{Config: DefaultConfig()}
+		c := NewConsole(setupDatabase(t))
 		ts := httptest.NewServer(c.HttpRouter())
 		defer ts.Close()
 
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
 
+		if _, err := c.DB.Write.CreateUser(ctx, database.CreateUserParams{Username: "tester", Password: "x"}); err != nil {
+			t.Fatal(err)
+		}
+		token, err := c.Sessions.Issue(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
 		uri := fmt.Sprintf("ws://%s/lobby", ts.URL[7:])
-		_, err := wire.Connect(ctx, uri, wire.User{
+		_, err = wire.Connect(ctx, uri, wire.User{
 			UserID:   1,
 			Username: "tester",
 			Version:  "dev",
-		})
+		}, token)
 		if err != nil {
 			t.Error(err)
 		}
 	})
+
+	t.Run("Connect to websocket without valid token", func(t *testing.T) {
+		c := NewConsole(setupDatabase(t))
+		ts := httptest.NewServer(c.HttpRouter())
+		defer ts.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		otherToken, err := c.Sessions.Issue(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		uri := fmt.Sprintf("ws://%s/lobby", ts.URL[7:])
+		user := wire.User{UserID: 1, Username: "tester", Version: "dev"}
+
+		_, err = wire.Connect(ctx, uri, user, "")
+		assert.ErrorContains(t, err, "401")
+
+		_, err = wire.Connect(ctx, uri, user, otherToken)
+		assert.ErrorContains(t, err, "403")
+	})
+	t.Run("Connect to websocket with unknown channel", func(t *testing.T) {
+		c := NewConsole(setupDatabase(t))
+		ts := httptest.NewServer(c.HttpRouter())
+		defer ts.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		if _, err := c.DB.Write.CreateUser(ctx, database.CreateUserParams{Username: "tester", Password: "x"}); err != nil {
+			t.Fatal(err)
+		}
+		token, err := c.Sessions.Issue(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		uri := fmt.Sprintf("ws://%s/lobby?channelName=UNKNOWN", ts.URL[7:])
+		_, err = wire.Connect(ctx, uri, wire.User{UserID: 1, Username: "tester", Version: "dev"}, token)
+		assert.ErrorContains(t, err, "400")
+	})
+	t.Run("Connect to websocket when banned", func(t *testing.T) {
+		c := NewConsole(setupDatabase(t))
+		ts := httptest.NewServer(c.HttpRouter())
+		defer ts.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		token, err := c.Sessions.Issue(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Bans.Create(ctx, 1, "", "cheating", "admin", 0); err != nil {
+			t.Fatal(err)
+		}
+
+		uri := fmt.Sprintf("ws://%s/lobby", ts.URL[7:])
+		_, err = wire.Connect(ctx, uri, wire.User{UserID: 1, Username: "tester", Version: "dev"}, token)
+		assert.ErrorContains(t, err, "403")
+	})
 }
@@ -1,3 +0,0 @@
-package database
-
-var Channels = []string{"DISPEL"}
@@ -24,33 +24,159 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.acceptFriendRequestStmt, err = db.PrepareContext(ctx, acceptFriendRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query AcceptFriendRequest: %w", err)
+	}
+	if q.createAnnouncementStmt, err = db.PrepareContext(ctx, createAnnouncement); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAnnouncement: %w", err)
+	}
+	if q.createBanStmt, err = db.PrepareContext(ctx, createBan); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateBan: %w", err)
+	}
+	if q.createChannelStmt, err = db.PrepareContext(ctx, createChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateChannel: %w", err)
+	}
 	if q.createCharacterStmt, err = db.PrepareContext(ctx, createCharacter); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCharacter: %w", err)
 	}
+	if q.createChatMessageStmt, err = db.PrepareContext(ctx, createChatMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateChatMessage: %w", err)
+	}
+	if q.createFriendRequestStmt, err = db.PrepareContext(ctx, createFriendRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateFriendRequest: %w", err)
+	}
+	if q.createGameRoomPlayerStmt, err = db.PrepareContext(ctx, createGameRoomPlayer); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateGameRoomPlayer: %w", err)
+	}
+	if q.createMatchStmt, err = db.PrepareContext(ctx, createMatch); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateMatch: %w", err)
+	}
+	if q.createMatchPlayerStmt, err = db.PrepareContext(ctx, createMatchPlayer); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateMatchPlayer: %w", err)
+	}
+	if q.createModerationLogEntryStmt, err = db.PrepareContext(ctx, createModerationLogEntry); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateModerationLogEntry: %w", err)
+	}
 	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
 	}
+	if q.deleteAnnouncementStmt, err = db.PrepareContext(ctx, deleteAnnouncement); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAnnouncement: %w", err)
+	}
+	if q.deleteBanStmt, err = db.PrepareContext(ctx, deleteBan); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteBan: %w", err)
+	}
 	if q.deleteCharacterStmt, err = db.PrepareContext(ctx, deleteCharacter); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCharacter: %w", err)
 	}
+	if q.deleteChatMessagesBeforeStmt, err = db.PrepareContext(ctx, deleteChatMessagesBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteChatMessagesBefore: %w", err)
+	}
+	if q.deleteFriendStmt, err = db.PrepareContext(ctx, deleteFriend); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteFriend: %w", err)
+	}
+	if q.deleteGameRoomStmt, err = db.PrepareContext(ctx, deleteGameRoom); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGameRoom: %w", err)
+	}
+	if q.deleteGameRoomPlayersStmt, err = db.PrepareContext(ctx, deleteGameRoomPlayers); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGameRoomPlayers: %w", err)
+	}
+	if q.deleteLoginAttemptStmt, err = db.PrepareContext(ctx, deleteLoginAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteLoginAttempt: %w", err)
+	}
+	if q.deleteMuteStmt, err = db.PrepareContext(ctx, deleteMute); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteMute: %w", err)
+	}
+	if q.deletePasswordResetStmt, err = db.PrepareContext(ctx, deletePasswordReset); err != nil {
+		return nil, fmt.Errorf("error preparing query DeletePasswordReset: %w", err)
+	}
 	if q.findCharacterStmt, err = db.PrepareContext(ctx, findCharacter); err != nil {
 		return nil, fmt.Errorf("error preparing query FindCharacter: %w", err)
 	}
+	if q.getActiveMuteStmt, err = db.PrepareContext(ctx, getActiveMute); err != nil {
+		return nil, fmt.Errorf("error preparing query GetActiveMute: %w", err)
+	}
+	if q.getChannelByNameStmt, err = db.PrepareContext(ctx, getChannelByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetChannelByName: %w", err)
+	}
+	if q.getCharacterLevelStmt, err = db.PrepareContext(ctx, getCharacterLevel); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCharacterLevel: %w", err)
+	}
 	if q.getCurrentUserStmt, err = db.PrepareContext(ctx, getCurrentUser); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCurrentUser: %w", err)
 	}
+	if q.getFriendRequestStmt, err = db.PrepareContext(ctx, getFriendRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFriendRequest: %w", err)
+	}
+	if q.getLoginAttemptStmt, err = db.PrepareContext(ctx, getLoginAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLoginAttempt: %w", err)
+	}
+	if q.getMatchStmt, err = db.PrepareContext(ctx, getMatch); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMatch: %w", err)
+	}
+	if q.getPasswordResetStmt, err = db.PrepareContext(ctx, getPasswordReset); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPasswordReset: %w", err)
+	}
 	if q.getUserByIDStmt, err = db.PrepareContext(ctx, getUserByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByID: %w", err)
 	}
 	if q.getUserByNameStmt, err = db.PrepareContext(ctx, getUserByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByName: %w", err)
 	}
+	if q.listActiveBansStmt, err = db.PrepareContext(ctx, listActiveBans); err != nil {
+		return nil, fmt.Errorf("error preparing query ListActiveBans: %w", err)
+	}
+	if q.listAnnouncementsStmt, err = db.PrepareContext(ctx, listAnnouncements); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAnnouncements: %w", err)
+	}
+	if q.listChannelsStmt, err = db.PrepareContext(ctx, listChannels); err != nil {
+		return nil, fmt.Errorf("error preparing query ListChannels: %w", err)
+	}
 	if q.listCharactersStmt, err = db.PrepareContext(ctx, listCharacters); err != nil {
 		return nil, fmt.Errorf("error preparing query ListCharacters: %w", err)
 	}
+	if q.listChatMessagesStmt, err = db.PrepareContext(ctx, listChatMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query ListChatMessages: %w", err)
+	}
+	if q.listDueAnnouncementsStmt, err = db.PrepareContext(ctx, listDueAnnouncements); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDueAnnouncements: %w", err)
+	}
+	if q.listFriendIDsStmt, err = db.PrepareContext(ctx, listFriendIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListFriendIDs: %w", err)
+	}
+	if q.listFriendsStmt, err = db.PrepareContext(ctx, listFriends); err != nil {
+		return nil, fmt.Errorf("error preparing query ListFriends: %w", err)
+	}
+	if q.listGameRoomPlayersStmt, err = db.PrepareContext(ctx, listGameRoomPlayers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGameRoomPlayers: %w", err)
+	}
+	if q.listGameRoomsStmt, err = db.PrepareContext(ctx, listGameRooms); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGameRooms: %w", err)
+	}
+	if q.listMatchPlayersStmt, err = db.PrepareContext(ctx, listMatchPlayers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListMatchPlayers: %w", err)
+	}
+	if q.listMatchesStmt, err = db.PrepareContext(ctx, listMatches); err != nil {
+		return nil, fmt.Errorf("error preparing query ListMatches: %w", err)
+	}
+	if q.listModerationLogStmt, err = db.PrepareContext(ctx, listModerationLog); err != nil {
+		return nil, fmt.Errorf("error preparing query ListModerationLog: %w", err)
+	}
+	if q.listPlayerMatchesStmt, err = db.PrepareContext(ctx, listPlayerMatches); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPlayerMatches: %w", err)
+	}
 	if q.selectRankingStmt, err = db.PrepareContext(ctx, selectRanking); err != nil {
 		return nil, fmt.Errorf("error preparing query SelectRanking: %w", err)
 	}
+	if q.trimChatMessagesStmt, err = db.PrepareContext(ctx, trimChatMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query TrimChatMessages: %w", err)
+	}
+	if q.updateAnnouncementNextRunStmt, err = db.PrepareContext(ctx, updateAnnouncementNextRun); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateAnnouncementNextRun: %w", err)
+	}
+	if q.updateChannelMOTDStmt, err = db.PrepareContext(ctx, updateChannelMOTD); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateChannelMOTD: %w", err)
+	}
 	if q.updateCharacterInventoryStmt, err = db.PrepareContext(ctx, updateCharacterInventory); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCharacterInventory: %w", err)
 	}
@@ -60,36 +186,184 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateCharacterStatsStmt, err = db.PrepareContext(ctx, updateCharacterStats); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCharacterStats: %w", err)
 	}
+	if q.updateUserPasswordStmt, err = db.PrepareContext(ctx, updateUserPassword); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserPassword: %w", err)
+	}
+	if q.updateUserRoleStmt, err = db.PrepareContext(ctx, updateUserRole); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserRole: %w", err)
+	}
+	if q.upsertGameRoomStmt, err = db.PrepareContext(ctx, upsertGameRoom); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertGameRoom: %w", err)
+	}
+	if q.upsertLoginAttemptStmt, err = db.PrepareContext(ctx, upsertLoginAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertLoginAttempt: %w", err)
+	}
+	if q.upsertMuteStmt, err = db.PrepareContext(ctx, upsertMute); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertMute: %w", err)
+	}
+	if q.upsertPasswordResetStmt, err = db.PrepareContext(ctx, upsertPasswordReset); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertPasswordReset: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.acceptFriendRequestStmt != nil {
+		if cerr := q.acceptFriendRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing acceptFriendRequestStmt: %w", cerr)
+		}
+	}
+	if q.createAnnouncementStmt != nil {
+		if cerr := q.createAnnouncementStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAnnouncementStmt: %w", cerr)
+		}
+	}
+	if q.createBanStmt != nil {
+		if cerr := q.createBanStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createBanStmt: %w", cerr)
+		}
+	}
+	if q.createChannelStmt != nil {
+		if cerr := q.createChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createChannelStmt: %w", cerr)
+		}
+	}
 	if q.createCharacterStmt != nil {
 		if cerr := q.createCharacterStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createCharacterStmt: %w", cerr)
 		}
 	}
+	if q.createChatMessageStmt != nil {
+		if cerr := q.createChatMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createChatMessageStmt: %w", cerr)
+		}
+	}
+	if q.createFriendRequestStmt != nil {
+		if cerr := q.createFriendRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createFriendRequestStmt: %w", cerr)
+		}
+	}
+	if q.createGameRoomPlayerStmt != nil {
+		if cerr := q.createGameRoomPlayerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createGameRoomPlayerStmt: %w", cerr)
+		}
+	}
+	if q.createMatchStmt != nil {
+		if cerr := q.createMatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createMatchStmt: %w", cerr)
+		}
+	}
+	if q.createMatchPlayerStmt != nil {
+		if cerr := q.createMatchPlayerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createMatchPlayerStmt: %w", cerr)
+		}
+	}
+	if q.createModerationLogEntryStmt != nil {
+		if cerr := q.createModerationLogEntryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createModerationLogEntryStmt: %w", cerr)
+		}
+	}
 	if q.createUserStmt != nil {
 		if cerr := q.createUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
 		}
 	}
+	if q.deleteAnnouncementStmt != nil {
+		if cerr := q.deleteAnnouncementStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAnnouncementStmt: %w", cerr)
+		}
+	}
+	if q.deleteBanStmt != nil {
+		if cerr := q.deleteBanStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteBanStmt: %w", cerr)
+		}
+	}
 	if q.deleteCharacterStmt != nil {
 		if cerr := q.deleteCharacterStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteCharacterStmt: %w", cerr)
 		}
 	}
+	if q.deleteChatMessagesBeforeStmt != nil {
+		if cerr := q.deleteChatMessagesBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteChatMessagesBeforeStmt: %w", cerr)
+		}
+	}
+	if q.deleteFriendStmt != nil {
+		if cerr := q.deleteFriendStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteFriendStmt: %w", cerr)
+		}
+	}
+	if q.deleteGameRoomStmt != nil {
+		if cerr := q.deleteGameRoomStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGameRoomStmt: %w", cerr)
+		}
+	}
+	if q.deleteGameRoomPlayersStmt != nil {
+		if cerr := q.deleteGameRoomPlayersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGameRoomPlayersStmt: %w", cerr)
+		}
+	}
+	if q.deleteLoginAttemptStmt != nil {
+		if cerr := q.deleteLoginAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteLoginAttemptStmt: %w", cerr)
+		}
+	}
+	if q.deleteMuteStmt != nil {
+		if cerr := q.deleteMuteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteMuteStmt: %w", cerr)
+		}
+	}
+	if q.deletePasswordResetStmt != nil {
+		if cerr := q.deletePasswordResetStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deletePasswordResetStmt: %w", cerr)
+		}
+	}
 	if q.findCharacterStmt != nil {
 		if cerr := q.findCharacterStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findCharacterStmt: %w", cerr)
 		}
 	}
+	if q.getActiveMuteStmt != nil {
+		if cerr := q.getActiveMuteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getActiveMuteStmt: %w", cerr)
+		}
+	}
+	if q.getChannelByNameStmt != nil {
+		if cerr := q.getChannelByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getChannelByNameStmt: %w", cerr)
+		}
+	}
+	if q.getCharacterLevelStmt != nil {
+		if cerr := q.getCharacterLevelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterLevelStmt: %w", cerr)
+		}
+	}
 	if q.getCurrentUserStmt != nil {
 		if cerr := q.getCurrentUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCurrentUserStmt: %w", cerr)
 		}
 	}
+	if q.getFriendRequestStmt != nil {
+		if cerr := q.getFriendRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFriendRequestStmt: %w", cerr)
+		}
+	}
+	if q.getLoginAttemptStmt != nil {
+		if cerr := q.getLoginAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLoginAttemptStmt: %w", cerr)
+		}
+	}
+	if q.getMatchStmt != nil {
+		if cerr := q.getMatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMatchStmt: %w", cerr)
+		}
+	}
+	if q.getPasswordResetStmt != nil {
+		if cerr := q.getPasswordResetStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPasswordResetStmt: %w", cerr)
+		}
+	}
 	if q.getUserByIDStmt != nil {
 		if cerr := q.getUserByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserByIDStmt: %w", cerr)
@@ -100,16 +374,96 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserByNameStmt: %w", cerr)
 		}
 	}
+	if q.listActiveBansStmt != nil {
+		if cerr := q.listActiveBansStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listActiveBansStmt: %w", cerr)
+		}
+	}
+	if q.listAnnouncementsStmt != nil {
+		if cerr := q.listAnnouncementsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAnnouncementsStmt: %w", cerr)
+		}
+	}
+	if q.listChannelsStmt != nil {
+		if cerr := q.listChannelsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listChannelsStmt: %w", cerr)
+		}
+	}
 	if q.listCharactersStmt != nil {
 		if cerr := q.listCharactersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listCharactersStmt: %w", cerr)
 		}
 	}
+	if q.listChatMessagesStmt != nil {
+		if cerr := q.listChatMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listChatMessagesStmt: %w", cerr)
+		}
+	}
+	if q.listDueAnnouncementsStmt != nil {
+		if cerr := q.listDueAnnouncementsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDueAnnouncementsStmt: %w", cerr)
+		}
+	}
+	if q.listFriendIDsStmt != nil {
+		if cerr := q.listFriendIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listFriendIDsStmt: %w", cerr)
+		}
+	}
+	if q.listFriendsStmt != nil {
+		if cerr := q.listFriendsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listFriendsStmt: %w", cerr)
+		}
+	}
+	if q.listGameRoomPlayersStmt != nil {
+		if cerr := q.listGameRoomPlayersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGameRoomPlayersStmt: %w", cerr)
+		}
+	}
+	if q.listGameRoomsStmt != nil {
+		if cerr := q.listGameRoomsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGameRoomsStmt: %w", cerr)
+		}
+	}
+	if q.listMatchPlayersStmt != nil {
+		if cerr := q.listMatchPlayersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listMatchPlayersStmt: %w", cerr)
+		}
+	}
+	if q.listMatchesStmt != nil {
+		if cerr := q.listMatchesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listMatchesStmt: %w", cerr)
+		}
+	}
+	if q.listModerationLogStmt != nil {
+		if cerr := q.listModerationLogStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listModerationLogStmt: %w", cerr)
+		}
+	}
+	if q.listPlayerMatchesStmt != nil {
+		if cerr := q.listPlayerMatchesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPlayerMatchesStmt: %w", cerr)
+		}
+	}
 	if q.selectRankingStmt != nil {
 		if cerr := q.selectRankingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing selectRankingStmt: %w", cerr)
 		}
 	}
+	if q.trimChatMessagesStmt != nil {
+		if cerr := q.trimChatMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing trimChatMessagesStmt: %w", cerr)
+		}
+	}
+	if q.updateAnnouncementNextRunStmt != nil {
+		if cerr := q.updateAnnouncementNextRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateAnnouncementNextRunStmt: %w", cerr)
+		}
+	}
+	if q.updateChannelMOTDStmt != nil {
+		if cerr := q.updateChannelMOTDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateChannelMOTDStmt: %w", cerr)
+		}
+	}
 	if q.updateCharacterInventoryStmt != nil {
 		if cerr := q.updateCharacterInventoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateCharacterInventoryStmt: %w", cerr)
@@ -125,6 +479,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateCharacterStatsStmt: %w", cerr)
 		}
 	}
+	if q.updateUserPasswordStmt != nil {
+		if cerr := q.updateUserPasswordStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserPasswordStmt: %w", cerr)
+		}
+	}
+	if q.updateUserRoleStmt != nil {
+		if cerr := q.updateUserRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserRoleStmt: %w", cerr)
+		}
+	}
+	if q.upsertGameRoomStmt != nil {
+		if cerr := q.upsertGameRoomStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertGameRoomStmt: %w", cerr)
+		}
+	}
+	if q.upsertLoginAttemptStmt != nil {
+		if cerr := q.upsertLoginAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertLoginAttemptStmt: %w", cerr)
+		}
+	}
+	if q.upsertMuteStmt != nil {
+		if cerr := q.upsertMuteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertMuteStmt: %w", cerr)
+		}
+	}
+	if q.upsertPasswordResetStmt != nil {
+		if cerr := q.upsertPasswordResetStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertPasswordResetStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -162,37 +546,133 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                           DBTX
-	tx                           *sql.Tx
-	createCharacterStmt          *sql.Stmt
-	createUserStmt               *sql.Stmt
-	deleteCharacterStmt          *sql.Stmt
-	findCharacterStmt            *sql.Stmt
-	getCurrentUserStmt           *sql.Stmt
-	getUserByIDStmt              *sql.Stmt
-	getUserByNameStmt            *sql.Stmt
-	listCharactersStmt           *sql.Stmt
-	selectRankingStmt            *sql.Stmt
-	updateCharacterInventoryStmt *sql.Stmt
-	updateCharacterSpellsStmt    *sql.Stmt
-	updateCharacterStatsStmt     *sql.Stmt
+	db                            DBTX
+	tx                            *sql.Tx
+	acceptFriendRequestStmt       *sql.Stmt
+	createAnnouncementStmt        *sql.Stmt
+	createBanStmt                 *sql.Stmt
+	createChannelStmt             *sql.Stmt
+	createCharacterStmt           *sql.Stmt
+	createChatMessageStmt         *sql.Stmt
+	createFriendRequestStmt       *sql.Stmt
+	createGameRoomPlayerStmt      *sql.Stmt
+	createMatchStmt               *sql.Stmt
+	createMatchPlayerStmt         *sql.Stmt
+	createModerationLogEntryStmt  *sql.Stmt
+	createUserStmt                *sql.Stmt
+	deleteAnnouncementStmt        *sql.Stmt
+	deleteBanStmt                 *sql.Stmt
+	deleteCharacterStmt           *sql.Stmt
+	deleteChatMessagesBeforeStmt  *sql.Stmt
+	deleteFriendStmt              *sql.Stmt
+	deleteGameRoomStmt            *sql.Stmt
+	deleteGameRoomPlayersStmt     *sql.Stmt
+	deleteLoginAttemptStmt        *sql.Stmt
+	deleteMuteStmt                *sql.Stmt
+	deletePasswordResetStmt       *sql.Stmt
+	findCharacterStmt             *sql.Stmt
+	getActiveMuteStmt             *sql.Stmt
+	getChannelByNameStmt          *sql.Stmt
+	getCharacterLevelStmt         *sql.Stmt
+	getCurrentUserStmt            *sql.Stmt
+	getFriendRequestStmt          *sql.Stmt
+	getLoginAttemptStmt           *sql.Stmt
+	getMatchStmt                  *sql.Stmt
+	getPasswordResetStmt          *sql.Stmt
+	getUserByIDStmt               *sql.Stmt
+	getUserByNameStmt             *sql.Stmt
+	listActiveBansStmt            *sql.Stmt
+	listAnnouncementsStmt         *sql.Stmt
+	listChannelsStmt              *sql.Stmt
+	listCharactersStmt            *sql.Stmt
+	listChatMessagesStmt          *sql.Stmt
+	listDueAnnouncementsStmt      *sql.Stmt
+	listFriendIDsStmt             *sql.Stmt
+	listFriendsStmt               *sql.Stmt
+	listGameRoomPlayersStmt       *sql.Stmt
+	listGameRoomsStmt             *sql.Stmt
+	listMatchPlayersStmt          *sql.Stmt
+	listMatchesStmt               *sql.Stmt
+	listModerationLogStmt         *sql.Stmt
+	listPlayerMatchesStmt         *sql.Stmt
+	selectRankingStmt             *sql.Stmt
+	trimChatMessagesStmt          *sql.Stmt
+	updateAnnouncementNextRunStmt *sql.Stmt
+	updateChannelMOTDStmt         *sql.Stmt
+	updateCharacterInventoryStmt  *sql.Stmt
+	updateCharacterSpellsStmt     *sql.Stmt
+	updateCharacterStatsStmt      *sql.Stmt
+	updateUserPasswordStmt        *sql.Stmt
+	updateUserRoleStmt            *sql.Stmt
+	upsertGameRoomStmt            *sql.Stmt
+	upsertLoginAttemptStmt        *sql.Stmt
+	upsertMuteStmt                *sql.Stmt
+	upsertPasswordResetStmt       *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                           tx,
-		tx:                           tx,
-		createCharacterStmt:          q.createCharacterStmt,
-		createUserStmt:               q.createUserStmt,
-		deleteCharacterStmt:          q.deleteCharacterStmt,
-		findCharacterStmt:            q.findCharacterStmt,
-		getCurrentUserStmt:           q.getCurrentUserStmt,
-		getUserByIDStmt:              q.getUserByIDStmt,
-		getUserByNameStmt:            q.getUserByNameStmt,
-		listCharactersStmt:           q.listCharactersStmt,
-		selectRankingStmt:            q.selectRankingStmt,
-		updateCharacterInventoryStmt: q.updateCharacterInventoryStmt,
-		updateCharacterSpellsStmt:    q.updateCharacterSpellsStmt,
-		updateCharacterStatsStmt:     q.updateCharacterStatsStmt,
+		db:                            tx,
+		tx:                            tx,
+		acceptFriendRequestStmt:       q.acceptFriendRequestStmt,
+		createAnnouncementStmt:        q.createAnnouncementStmt,
+		createBanStmt:                 q.createBanStmt,
+		createChannelStmt:             q.createChannelStmt,
+		createCharacterStmt:           q.createCharacterStmt,
+		createChatMessageStmt:         q.createChatMessageStmt,
+		createFriendRequestStmt:       q.createFriendRequestStmt,
+		createGameRoomPlayerStmt:      q.createGameRoomPlayerStmt,
+		createMatchStmt:               q.createMatchStmt,
+		createMatchPlayerStmt:         q.createMatchPlayerStmt,
+		createModerationLogEntryStmt:  q.createModerationLogEntryStmt,
+		createUserStmt:                q.createUserStmt,
+		deleteAnnouncementStmt:        q.deleteAnnouncementStmt,
+		deleteBanStmt:                 q.deleteBanStmt,
+		deleteCharacterStmt:           q.deleteCharacterStmt,
+		deleteChatMessagesBeforeStmt:  q.deleteChatMessagesBeforeStmt,
+		deleteFriendStmt:              q.deleteFriendStmt,
+		deleteGameRoomStmt:            q.deleteGameRoomStmt,
+		deleteGameRoomPlayersStmt:     q.deleteGameRoomPlayersStmt,
+		deleteLoginAttemptStmt:        q.deleteLoginAttemptStmt,
+		deleteMuteStmt:                q.deleteMuteStmt,
+		deletePasswordResetStmt:       q.deletePasswordResetStmt,
+		findCharacterStmt:             q.findCharacterStmt,
+		getActiveMuteStmt:             q.getActiveMuteStmt,
+		getChannelByNameStmt:          q.getChannelByNameStmt,
+		getCharacterLevelStmt:         q.getCharacterLevelStmt,
+		getCurrentUserStmt:            q.getCurrentUserStmt,
+		getFriendRequestStmt:          q.getFriendRequestStmt,
+		getLoginAttemptStmt:           q.getLoginAttemptStmt,
+		getMatchStmt:                  q.getMatchStmt,
+		getPasswordResetStmt:          q.getPasswordResetStmt,
+		getUserByIDStmt:               q.getUserByIDStmt,
+		getUserByNameStmt:             q.getUserByNameStmt,
+		listActiveBansStmt:            q.listActiveBansStmt,
+		listAnnouncementsStmt:         q.listAnnouncementsStmt,
+		listChannelsStmt:              q.listChannelsStmt,
+		listCharactersStmt:            q.listCharactersStmt,
+		listChatMessagesStmt:          q.listChatMessagesStmt,
+		listDueAnnouncementsStmt:      q.listDueAnnouncementsStmt,
+		listFriendIDsStmt:             q.listFriendIDsStmt,
+		listFriendsStmt:               q.listFriendsStmt,
+		listGameRoomPlayersStmt:       q.listGameRoomPlayersStmt,
+		listGameRoomsStmt:             q.listGameRoomsStmt,
+		listMatchPlayersStmt:          q.listMatchPlayersStmt,
+		listMatchesStmt:               q.listMatchesStmt,
+		listModerationLogStmt:         q.listModerationLogStmt,
+		listPlayerMatchesStmt:         q.listPlayerMatchesStmt,
+		selectRankingStmt:             q.selectRankingStmt,
+		trimChatMessagesStmt:          q.trimChatMessagesStmt,
+		updateAnnouncementNextRunStmt: q.updateAnnouncementNextRunStmt,
+		updateChannelMOTDStmt:         q.updateChannelMOTDStmt,
+		updateCharacterInventoryStmt:  q.updateCharacterInventoryStmt,
+		updateCharacterSpellsStmt:     q.updateCharacterSpellsStmt,
+		updateCharacterStatsStmt:      q.updateCharacterStatsStmt,
+		updateUserPasswordStmt:        q.updateUserPasswordStmt,
+		updateUserRoleStmt:            q.updateUserRoleStmt,
+		upsertGameRoomStmt:            q.upsertGameRoomStmt,
+		upsertLoginAttemptStmt:        q.upsertLoginAttemptStmt,
+		upsertMuteStmt:                q.upsertMuteStmt,
+		upsertPasswordResetStmt:       q.upsertPasswordResetStmt,
 	}
 }
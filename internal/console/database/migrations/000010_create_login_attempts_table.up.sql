@@ -0,0 +1,9 @@
+CREATE TABLE login_attempts
+(
+    scope        TEXT    NOT NULL,
+    subject      TEXT    NOT NULL,
+    failures     INTEGER NOT NULL DEFAULT 0,
+    last_failure INTEGER NOT NULL DEFAULT 0,
+    locked_until INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (scope, subject)
+);
@@ -0,0 +1,6 @@
+CREATE TABLE password_resets
+(
+    user_id    INTEGER PRIMARY KEY,
+    code_hash  TEXT    NOT NULL,
+    expires_at INTEGER NOT NULL
+);
@@ -0,0 +1,11 @@
+CREATE TABLE bans
+(
+    id         INTEGER PRIMARY KEY,
+    user_id    INTEGER,
+    cidr       TEXT,
+    reason     TEXT    NOT NULL DEFAULT '',
+    issued_by  TEXT    NOT NULL,
+    created_at INTEGER NOT NULL,
+    expires_at INTEGER,
+    CHECK ((user_id IS NULL) != (cidr IS NULL))
+);
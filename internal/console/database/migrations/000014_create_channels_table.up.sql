@@ -0,0 +1,12 @@
+CREATE TABLE channels
+(
+    id          INTEGER PRIMARY KEY,
+    name        TEXT    NOT NULL UNIQUE,
+    description TEXT    NOT NULL DEFAULT '',
+    password    TEXT,
+    max_users   INTEGER NOT NULL DEFAULT 0,
+    min_level   INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO channels (name, description)
+VALUES ('DISPEL', 'Default channel');
@@ -0,0 +1,11 @@
+CREATE TABLE chat_messages
+(
+    id         INTEGER PRIMARY KEY,
+    channel    TEXT    NOT NULL,
+    user_id    INTEGER NOT NULL,
+    username   TEXT    NOT NULL,
+    text       TEXT    NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX chat_messages_channel_id ON chat_messages (channel, id);
@@ -0,0 +1,19 @@
+CREATE TABLE mutes
+(
+    user_id    INTEGER PRIMARY KEY,
+    reason     TEXT    NOT NULL DEFAULT '',
+    issued_by  TEXT    NOT NULL,
+    created_at INTEGER NOT NULL,
+    expires_at INTEGER NOT NULL
+);
+
+CREATE TABLE moderation_log
+(
+    id         INTEGER PRIMARY KEY,
+    action     TEXT    NOT NULL,
+    user_id    INTEGER NOT NULL,
+    channel    TEXT    NOT NULL DEFAULT '',
+    actor      TEXT    NOT NULL,
+    reason     TEXT    NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL
+);
@@ -0,0 +1,11 @@
+CREATE TABLE friends
+(
+    user_id    INTEGER NOT NULL,
+    friend_id  INTEGER NOT NULL,
+    accepted   BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (user_id, friend_id),
+    CHECK (user_id != friend_id)
+);
+
+CREATE INDEX friends_friend_id ON friends (friend_id);
@@ -0,0 +1 @@
+ALTER TABLE channels DROP COLUMN motd;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE channels ADD COLUMN motd TEXT NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE announcements
+(
+    id               INTEGER PRIMARY KEY,
+    text             TEXT    NOT NULL,
+    channel          TEXT    NOT NULL DEFAULT '',
+    interval_seconds INTEGER NOT NULL DEFAULT 0,
+    next_run_at      INTEGER NOT NULL,
+    created_by       TEXT    NOT NULL,
+    created_at       INTEGER NOT NULL
+);
+
+CREATE INDEX announcements_next_run_at ON announcements (next_run_at);
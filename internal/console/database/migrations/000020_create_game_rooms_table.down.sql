@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS game_room_players;
+
+DROP TABLE IF EXISTS game_rooms;
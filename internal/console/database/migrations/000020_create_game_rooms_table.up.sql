@@ -0,0 +1,23 @@
+CREATE TABLE game_rooms
+(
+    id           TEXT PRIMARY KEY,
+    name         TEXT    NOT NULL,
+    password     TEXT    NOT NULL DEFAULT '',
+    map_id       INTEGER NOT NULL,
+    ready        BOOLEAN NOT NULL DEFAULT FALSE,
+    host_user_id INTEGER NOT NULL,
+    created_by   INTEGER NOT NULL,
+    created_at   INTEGER NOT NULL
+);
+
+CREATE TABLE game_room_players
+(
+    game_room_id TEXT    NOT NULL,
+    user_id      INTEGER NOT NULL,
+    username     TEXT    NOT NULL,
+    character_id INTEGER NOT NULL,
+    class_type   INTEGER NOT NULL,
+    ip_address   TEXT    NOT NULL,
+    joined_at    INTEGER NOT NULL,
+    PRIMARY KEY (game_room_id, user_id)
+);
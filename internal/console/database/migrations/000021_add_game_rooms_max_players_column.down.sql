@@ -0,0 +1 @@
+ALTER TABLE game_rooms DROP COLUMN max_players;
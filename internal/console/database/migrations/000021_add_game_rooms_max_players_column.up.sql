@@ -0,0 +1 @@
+ALTER TABLE game_rooms ADD COLUMN max_players INTEGER NOT NULL DEFAULT 4;
@@ -0,0 +1 @@
+ALTER TABLE game_rooms RENAME COLUMN password_hash TO password;
@@ -0,0 +1,10 @@
+ALTER TABLE game_rooms RENAME COLUMN password TO password_hash;
+
+-- The rooms stored before contain the passwords in plain text, they cannot be
+-- verified against the hash.
+DELETE
+FROM game_room_players
+WHERE game_room_id IN (SELECT id FROM game_rooms WHERE password_hash != '');
+DELETE
+FROM game_rooms
+WHERE password_hash != '';
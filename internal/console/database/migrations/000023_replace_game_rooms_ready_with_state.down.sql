@@ -0,0 +1,3 @@
+ALTER TABLE game_rooms ADD COLUMN ready BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE game_rooms SET ready = TRUE WHERE state != 0;
+ALTER TABLE game_rooms DROP COLUMN state;
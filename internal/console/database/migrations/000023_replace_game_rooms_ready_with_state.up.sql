@@ -0,0 +1,3 @@
+ALTER TABLE game_rooms ADD COLUMN state INTEGER NOT NULL DEFAULT 0;
+UPDATE game_rooms SET state = 1 WHERE ready;
+ALTER TABLE game_rooms DROP COLUMN ready;
@@ -0,0 +1,30 @@
+CREATE TABLE matches
+(
+    id           INTEGER PRIMARY KEY,
+    game_room_id TEXT    NOT NULL,
+    name         TEXT    NOT NULL,
+    map_id       INTEGER NOT NULL,
+    host_user_id INTEGER NOT NULL,
+    started_at   INTEGER NOT NULL,
+    ended_at     INTEGER NOT NULL
+);
+
+CREATE INDEX matches_ended_at ON matches (ended_at);
+
+CREATE TABLE match_players
+(
+    id               INTEGER PRIMARY KEY,
+    match_id         INTEGER NOT NULL,
+    user_id          INTEGER NOT NULL,
+    username         TEXT    NOT NULL,
+    character_id     INTEGER NOT NULL,
+    class_type       INTEGER NOT NULL,
+    joined_at        INTEGER NOT NULL,
+    left_at          INTEGER NOT NULL,
+    score_delta      INTEGER NOT NULL DEFAULT 0,
+    experience_delta INTEGER NOT NULL DEFAULT 0,
+    money_delta      INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX match_players_match_id ON match_players (match_id);
+CREATE INDEX match_players_user_id ON match_players (user_id, match_id);
@@ -8,6 +8,36 @@ import (
 	"database/sql"
 )
 
+type Announcement struct {
+	ID              int64
+	Text            string
+	Channel         string
+	IntervalSeconds int64
+	NextRunAt       int64
+	CreatedBy       string
+	CreatedAt       int64
+}
+
+type Ban struct {
+	ID        int64
+	UserID    sql.NullInt64
+	Cidr      sql.NullString
+	Reason    string
+	IssuedBy  string
+	CreatedAt int64
+	ExpiresAt sql.NullInt64
+}
+
+type Channel struct {
+	ID          int64
+	Name        string
+	Description string
+	Password    sql.NullString
+	MaxUsers    int64
+	MinLevel    int64
+	Motd        string
+}
+
 type Character struct {
 	ID                   int64
 	UserID               int64
@@ -49,26 +79,103 @@ type Character struct {
 	Spells               sql.NullString
 }
 
+type ChatMessage struct {
+	ID        int64
+	Channel   string
+	UserID    int64
+	Username  string
+	Text      string
+	CreatedAt int64
+}
+
+type Friend struct {
+	UserID    int64
+	FriendID  int64
+	Accepted  bool
+	CreatedAt int64
+}
+
 type GameRoom struct {
-	ID            int64
-	Name          string
-	Password      sql.NullString
-	HostIpAddress string
-	MapID         int64
-	CreatedBy     int64
-	HostUserID    int64
+	ID           string
+	Name         string
+	PasswordHash string
+	MapID        int64
+	HostUserID   int64
+	CreatedBy    int64
+	CreatedAt    int64
+	MaxPlayers   int64
+	State        int64
 }
 
 type GameRoomPlayer struct {
-	GameRoomID  int64
+	GameRoomID  string
 	UserID      int64
+	Username    string
 	CharacterID int64
+	ClassType   int64
 	IpAddress   string
-	AddedAt     int64
+	JoinedAt    int64
+}
+
+type LoginAttempt struct {
+	Scope       string
+	Subject     string
+	Failures    int64
+	LastFailure int64
+	LockedUntil int64
+}
+
+type Match struct {
+	ID         int64
+	GameRoomID string
+	Name       string
+	MapID      int64
+	HostUserID int64
+	StartedAt  int64
+	EndedAt    int64
+}
+
+type MatchPlayer struct {
+	ID              int64
+	MatchID         int64
+	UserID          int64
+	Username        string
+	CharacterID     int64
+	ClassType       int64
+	JoinedAt        int64
+	LeftAt          int64
+	ScoreDelta      int64
+	ExperienceDelta int64
+	MoneyDelta      int64
+}
+
+type ModerationLog struct {
+	ID        int64
+	Action    string
+	UserID    int64
+	Channel   string
+	Actor     string
+	Reason    string
+	CreatedAt int64
+}
+
+type Mute struct {
+	UserID    int64
+	Reason    string
+	IssuedBy  string
+	CreatedAt int64
+	ExpiresAt int64
+}
+
+type PasswordReset struct {
+	UserID    int64
+	CodeHash  string
+	ExpiresAt int64
 }
 
 type User struct {
 	ID       int64
 	Username string
 	Password string
+	Role     string
 }
@@ -15,6 +15,16 @@ INSERT INTO users (username, password)
 VALUES (?, ?)
 RETURNING *;
 
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password = ?
+WHERE id = ?;
+
+-- name: UpdateUserRole :execrows
+UPDATE users
+SET role = ?
+WHERE id = ?;
+
 -- name: ListCharacters :many
 SELECT *
 FROM characters
@@ -143,3 +153,286 @@ FROM (SELECT ROW_NUMBER() over (ORDER BY score_points) as position,
       WHERE users.id = ?
         AND characters.character_name = ?) as cte
 LIMIT 1;
+
+-- name: GetLoginAttempt :one
+SELECT *
+FROM login_attempts
+WHERE scope = ?
+  AND subject = ?
+LIMIT 1;
+
+-- name: UpsertLoginAttempt :exec
+INSERT INTO login_attempts (scope, subject, failures, last_failure, locked_until)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (scope, subject) DO UPDATE SET failures     = excluded.failures,
+                                           last_failure = excluded.last_failure,
+                                           locked_until = excluded.locked_until;
+
+-- name: DeleteLoginAttempt :exec
+DELETE
+FROM login_attempts
+WHERE scope = ?
+  AND subject = ?;
+
+-- name: GetPasswordReset :one
+SELECT *
+FROM password_resets
+WHERE user_id = ?
+LIMIT 1;
+
+-- name: UpsertPasswordReset :exec
+INSERT INTO password_resets (user_id, code_hash, expires_at)
+VALUES (?, ?, ?)
+ON CONFLICT (user_id) DO UPDATE SET code_hash  = excluded.code_hash,
+                                    expires_at = excluded.expires_at;
+
+-- name: DeletePasswordReset :exec
+DELETE
+FROM password_resets
+WHERE user_id = ?;
+
+-- name: CreateBan :one
+INSERT INTO bans (user_id, cidr, reason, issued_by, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: ListActiveBans :many
+SELECT *
+FROM bans
+WHERE expires_at IS NULL
+   OR expires_at > ?
+ORDER BY id;
+
+-- name: DeleteBan :execrows
+DELETE
+FROM bans
+WHERE id = ?;
+
+-- name: CreateChannel :one
+INSERT INTO channels (name, description, password, max_users, min_level)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: ListChannels :many
+SELECT *
+FROM channels
+ORDER BY id;
+
+-- name: GetChannelByName :one
+SELECT *
+FROM channels
+WHERE name = ?
+LIMIT 1;
+
+-- name: GetCharacterLevel :one
+SELECT level
+FROM characters
+WHERE id = ?
+  AND user_id = ?;
+
+-- name: CreateChatMessage :one
+INSERT INTO chat_messages (channel, user_id, username, text, created_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: ListChatMessages :many
+SELECT *
+FROM chat_messages
+WHERE channel = ?
+  AND id < ?
+ORDER BY id DESC
+LIMIT ?;
+
+-- name: TrimChatMessages :execrows
+DELETE
+FROM chat_messages
+WHERE channel = ?
+  AND id NOT IN (SELECT id
+                 FROM chat_messages
+                 WHERE channel = ?
+                 ORDER BY id DESC
+                 LIMIT ?);
+
+-- name: DeleteChatMessagesBefore :execrows
+DELETE
+FROM chat_messages
+WHERE created_at < ?;
+
+-- name: UpsertMute :one
+INSERT INTO mutes (user_id, reason, issued_by, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (user_id) DO UPDATE SET reason     = excluded.reason,
+                                    issued_by  = excluded.issued_by,
+                                    created_at = excluded.created_at,
+                                    expires_at = excluded.expires_at
+RETURNING *;
+
+-- name: GetActiveMute :one
+SELECT *
+FROM mutes
+WHERE user_id = ?
+  AND expires_at > ?
+LIMIT 1;
+
+-- name: DeleteMute :execrows
+DELETE
+FROM mutes
+WHERE user_id = ?;
+
+-- name: CreateModerationLogEntry :exec
+INSERT INTO moderation_log (action, user_id, channel, actor, reason, created_at)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: ListModerationLog :many
+SELECT *
+FROM moderation_log
+WHERE id < ?
+ORDER BY id DESC
+LIMIT ?;
+
+-- name: CreateFriendRequest :execrows
+INSERT INTO friends (user_id, friend_id, created_at)
+VALUES (?, ?, ?)
+ON CONFLICT DO NOTHING;
+
+-- name: GetFriendRequest :one
+SELECT *
+FROM friends
+WHERE user_id = ?
+  AND friend_id = ?;
+
+-- name: AcceptFriendRequest :execrows
+UPDATE friends
+SET accepted = TRUE
+WHERE user_id = ?
+  AND friend_id = ?;
+
+-- name: DeleteFriend :execrows
+DELETE
+FROM friends
+WHERE (user_id = sqlc.arg(user_id) AND friend_id = sqlc.arg(friend_id))
+   OR (user_id = sqlc.arg(friend_id) AND friend_id = sqlc.arg(user_id));
+
+-- name: ListFriends :many
+SELECT users.id, users.username, friends.accepted, friends.user_id AS requested_by
+FROM friends
+         JOIN users ON users.id = friends.friend_id
+WHERE friends.user_id = sqlc.arg(user_id)
+UNION ALL
+SELECT users.id, users.username, friends.accepted, friends.user_id AS requested_by
+FROM friends
+         JOIN users ON users.id = friends.user_id
+WHERE friends.friend_id = sqlc.arg(user_id)
+ORDER BY username;
+
+-- name: ListFriendIDs :many
+SELECT friend_id
+FROM friends
+WHERE user_id = sqlc.arg(user_id)
+  AND accepted
+UNION
+SELECT user_id
+FROM friends
+WHERE friend_id = sqlc.arg(user_id)
+  AND accepted;
+
+-- name: UpdateChannelMOTD :execrows
+UPDATE channels
+SET motd = ?
+WHERE name = ?;
+
+-- name: CreateAnnouncement :one
+INSERT INTO announcements (text, channel, interval_seconds, next_run_at, created_by, created_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: ListAnnouncements :many
+SELECT *
+FROM announcements
+ORDER BY id;
+
+-- name: ListDueAnnouncements :many
+SELECT *
+FROM announcements
+WHERE next_run_at <= ?
+ORDER BY next_run_at, id;
+
+-- name: UpdateAnnouncementNextRun :exec
+UPDATE announcements
+SET next_run_at = ?
+WHERE id = ?;
+
+-- name: DeleteAnnouncement :execrows
+DELETE
+FROM announcements
+WHERE id = ?;
+
+-- name: UpsertGameRoom :exec
+INSERT INTO game_rooms (id, name, password_hash, map_id, host_user_id, created_by, created_at, max_players, state)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET name          = excluded.name,
+                               password_hash = excluded.password_hash,
+                               map_id        = excluded.map_id,
+                               host_user_id  = excluded.host_user_id,
+                               max_players   = excluded.max_players,
+                               state         = excluded.state;
+
+-- name: ListGameRooms :many
+SELECT *
+FROM game_rooms
+ORDER BY created_at, id;
+
+-- name: DeleteGameRoom :exec
+DELETE
+FROM game_rooms
+WHERE id = ?;
+
+-- name: CreateGameRoomPlayer :exec
+INSERT INTO game_room_players (game_room_id, user_id, username, character_id, class_type, ip_address, joined_at)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListGameRoomPlayers :many
+SELECT *
+FROM game_room_players
+ORDER BY game_room_id, joined_at, user_id;
+
+-- name: DeleteGameRoomPlayers :exec
+DELETE
+FROM game_room_players
+WHERE game_room_id = ?;
+
+-- name: CreateMatch :one
+INSERT INTO matches (game_room_id, name, map_id, host_user_id, started_at, ended_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: CreateMatchPlayer :exec
+INSERT INTO match_players (match_id, user_id, username, character_id, class_type, joined_at, left_at, score_delta,
+                           experience_delta, money_delta)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetMatch :one
+SELECT *
+FROM matches
+WHERE id = ?;
+
+-- name: ListMatches :many
+SELECT *
+FROM matches
+ORDER BY ended_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: ListPlayerMatches :many
+SELECT *
+FROM matches
+WHERE id IN (SELECT match_id
+             FROM match_players
+             WHERE user_id = ?)
+ORDER BY ended_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: ListMatchPlayers :many
+SELECT *
+FROM match_players
+WHERE match_id = ?
+ORDER BY joined_at, id;
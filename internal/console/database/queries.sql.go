@@ -10,6 +10,135 @@ import (
 	"database/sql"
 )
 
+const acceptFriendRequest = `-- name: AcceptFriendRequest :execrows
+UPDATE friends
+SET accepted = TRUE
+WHERE user_id = ?
+  AND friend_id = ?
+`
+
+type AcceptFriendRequestParams struct {
+	UserID   int64
+	FriendID int64
+}
+
+func (q *Queries) AcceptFriendRequest(ctx context.Context, arg AcceptFriendRequestParams) (int64, error) {
+	result, err := q.exec(ctx, q.acceptFriendRequestStmt, acceptFriendRequest, arg.UserID, arg.FriendID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createAnnouncement = `-- name: CreateAnnouncement :one
+INSERT INTO announcements (text, channel, interval_seconds, next_run_at, created_by, created_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id, text, channel, interval_seconds, next_run_at, created_by, created_at
+`
+
+type CreateAnnouncementParams struct {
+	Text            string
+	Channel         string
+	IntervalSeconds int64
+	NextRunAt       int64
+	CreatedBy       string
+	CreatedAt       int64
+}
+
+func (q *Queries) CreateAnnouncement(ctx context.Context, arg CreateAnnouncementParams) (Announcement, error) {
+	row := q.queryRow(ctx, q.createAnnouncementStmt, createAnnouncement,
+		arg.Text,
+		arg.Channel,
+		arg.IntervalSeconds,
+		arg.NextRunAt,
+		arg.CreatedBy,
+		arg.CreatedAt,
+	)
+	var i Announcement
+	err := row.Scan(
+		&i.ID,
+		&i.Text,
+		&i.Channel,
+		&i.IntervalSeconds,
+		&i.NextRunAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createBan = `-- name: CreateBan :one
+INSERT INTO bans (user_id, cidr, reason, issued_by, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id, user_id, cidr, reason, issued_by, created_at, expires_at
+`
+
+type CreateBanParams struct {
+	UserID    sql.NullInt64
+	Cidr      sql.NullString
+	Reason    string
+	IssuedBy  string
+	CreatedAt int64
+	ExpiresAt sql.NullInt64
+}
+
+func (q *Queries) CreateBan(ctx context.Context, arg CreateBanParams) (Ban, error) {
+	row := q.queryRow(ctx, q.createBanStmt, createBan,
+		arg.UserID,
+		arg.Cidr,
+		arg.Reason,
+		arg.IssuedBy,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i Ban
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Cidr,
+		&i.Reason,
+		&i.IssuedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const createChannel = `-- name: CreateChannel :one
+INSERT INTO channels (name, description, password, max_users, min_level)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, name, description, password, max_users, min_level, motd
+`
+
+type CreateChannelParams struct {
+	Name        string
+	Description string
+	Password    sql.NullString
+	MaxUsers    int64
+	MinLevel    int64
+}
+
+func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (Channel, error) {
+	row := q.queryRow(ctx, q.createChannelStmt, createChannel,
+		arg.Name,
+		arg.Description,
+		arg.Password,
+		arg.MaxUsers,
+		arg.MinLevel,
+	)
+	var i Channel
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Password,
+		&i.MaxUsers,
+		&i.MinLevel,
+		&i.Motd,
+	)
+	return i, err
+}
+
 const createCharacter = `-- name: CreateCharacter :one
 INSERT INTO characters (strength,
                         agility,
@@ -170,10 +299,190 @@ func (q *Queries) CreateCharacter(ctx context.Context, arg CreateCharacterParams
 	return i, err
 }
 
+const createChatMessage = `-- name: CreateChatMessage :one
+INSERT INTO chat_messages (channel, user_id, username, text, created_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, channel, user_id, username, text, created_at
+`
+
+type CreateChatMessageParams struct {
+	Channel   string
+	UserID    int64
+	Username  string
+	Text      string
+	CreatedAt int64
+}
+
+func (q *Queries) CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) (ChatMessage, error) {
+	row := q.queryRow(ctx, q.createChatMessageStmt, createChatMessage,
+		arg.Channel,
+		arg.UserID,
+		arg.Username,
+		arg.Text,
+		arg.CreatedAt,
+	)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.Channel,
+		&i.UserID,
+		&i.Username,
+		&i.Text,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createFriendRequest = `-- name: CreateFriendRequest :execrows
+INSERT INTO friends (user_id, friend_id, created_at)
+VALUES (?, ?, ?)
+ON CONFLICT DO NOTHING
+`
+
+type CreateFriendRequestParams struct {
+	UserID    int64
+	FriendID  int64
+	CreatedAt int64
+}
+
+func (q *Queries) CreateFriendRequest(ctx context.Context, arg CreateFriendRequestParams) (int64, error) {
+	result, err := q.exec(ctx, q.createFriendRequestStmt, createFriendRequest, arg.UserID, arg.FriendID, arg.CreatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createGameRoomPlayer = `-- name: CreateGameRoomPlayer :exec
+INSERT INTO game_room_players (game_room_id, user_id, username, character_id, class_type, ip_address, joined_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateGameRoomPlayerParams struct {
+	GameRoomID  string
+	UserID      int64
+	Username    string
+	CharacterID int64
+	ClassType   int64
+	IpAddress   string
+	JoinedAt    int64
+}
+
+func (q *Queries) CreateGameRoomPlayer(ctx context.Context, arg CreateGameRoomPlayerParams) error {
+	_, err := q.exec(ctx, q.createGameRoomPlayerStmt, createGameRoomPlayer,
+		arg.GameRoomID,
+		arg.UserID,
+		arg.Username,
+		arg.CharacterID,
+		arg.ClassType,
+		arg.IpAddress,
+		arg.JoinedAt,
+	)
+	return err
+}
+
+const createMatch = `-- name: CreateMatch :one
+INSERT INTO matches (game_room_id, name, map_id, host_user_id, started_at, ended_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id, game_room_id, name, map_id, host_user_id, started_at, ended_at
+`
+
+type CreateMatchParams struct {
+	GameRoomID string
+	Name       string
+	MapID      int64
+	HostUserID int64
+	StartedAt  int64
+	EndedAt    int64
+}
+
+func (q *Queries) CreateMatch(ctx context.Context, arg CreateMatchParams) (Match, error) {
+	row := q.queryRow(ctx, q.createMatchStmt, createMatch,
+		arg.GameRoomID,
+		arg.Name,
+		arg.MapID,
+		arg.HostUserID,
+		arg.StartedAt,
+		arg.EndedAt,
+	)
+	var i Match
+	err := row.Scan(
+		&i.ID,
+		&i.GameRoomID,
+		&i.Name,
+		&i.MapID,
+		&i.HostUserID,
+		&i.StartedAt,
+		&i.EndedAt,
+	)
+	return i, err
+}
+
+const createMatchPlayer = `-- name: CreateMatchPlayer :exec
+INSERT INTO match_players (match_id, user_id, username, character_id, class_type, joined_at, left_at, score_delta,
+                           experience_delta, money_delta)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateMatchPlayerParams struct {
+	MatchID         int64
+	UserID          int64
+	Username        string
+	CharacterID     int64
+	ClassType       int64
+	JoinedAt        int64
+	LeftAt          int64
+	ScoreDelta      int64
+	ExperienceDelta int64
+	MoneyDelta      int64
+}
+
+func (q *Queries) CreateMatchPlayer(ctx context.Context, arg CreateMatchPlayerParams) error {
+	_, err := q.exec(ctx, q.createMatchPlayerStmt, createMatchPlayer,
+		arg.MatchID,
+		arg.UserID,
+		arg.Username,
+		arg.CharacterID,
+		arg.ClassType,
+		arg.JoinedAt,
+		arg.LeftAt,
+		arg.ScoreDelta,
+		arg.ExperienceDelta,
+		arg.MoneyDelta,
+	)
+	return err
+}
+
+const createModerationLogEntry = `-- name: CreateModerationLogEntry :exec
+INSERT INTO moderation_log (action, user_id, channel, actor, reason, created_at)
+VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateModerationLogEntryParams struct {
+	Action    string
+	UserID    int64
+	Channel   string
+	Actor     string
+	Reason    string
+	CreatedAt int64
+}
+
+func (q *Queries) CreateModerationLogEntry(ctx context.Context, arg CreateModerationLogEntryParams) error {
+	_, err := q.exec(ctx, q.createModerationLogEntryStmt, createModerationLogEntry,
+		arg.Action,
+		arg.UserID,
+		arg.Channel,
+		arg.Actor,
+		arg.Reason,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (username, password)
 VALUES (?, ?)
-RETURNING id, username, password
+RETURNING id, username, password, role
 `
 
 type CreateUserParams struct {
@@ -184,10 +493,43 @@ type CreateUserParams struct {
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
 	row := q.queryRow(ctx, q.createUserStmt, createUser, arg.Username, arg.Password)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.Password)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Password,
+		&i.Role,
+	)
 	return i, err
 }
 
+const deleteAnnouncement = `-- name: DeleteAnnouncement :execrows
+DELETE
+FROM announcements
+WHERE id = ?
+`
+
+func (q *Queries) DeleteAnnouncement(ctx context.Context, id int64) (int64, error) {
+	result, err := q.exec(ctx, q.deleteAnnouncementStmt, deleteAnnouncement, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteBan = `-- name: DeleteBan :execrows
+DELETE
+FROM bans
+WHERE id = ?
+`
+
+func (q *Queries) DeleteBan(ctx context.Context, id int64) (int64, error) {
+	result, err := q.exec(ctx, q.deleteBanStmt, deleteBan, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteCharacter = `-- name: DeleteCharacter :exec
 DELETE
 FROM characters
@@ -205,6 +547,104 @@ func (q *Queries) DeleteCharacter(ctx context.Context, arg DeleteCharacterParams
 	return err
 }
 
+const deleteChatMessagesBefore = `-- name: DeleteChatMessagesBefore :execrows
+DELETE
+FROM chat_messages
+WHERE created_at < ?
+`
+
+func (q *Queries) DeleteChatMessagesBefore(ctx context.Context, createdAt int64) (int64, error) {
+	result, err := q.exec(ctx, q.deleteChatMessagesBeforeStmt, deleteChatMessagesBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteFriend = `-- name: DeleteFriend :execrows
+DELETE
+FROM friends
+WHERE (user_id = ?1 AND friend_id = ?2)
+   OR (user_id = ?2 AND friend_id = ?1)
+`
+
+type DeleteFriendParams struct {
+	UserID   int64
+	FriendID int64
+}
+
+func (q *Queries) DeleteFriend(ctx context.Context, arg DeleteFriendParams) (int64, error) {
+	result, err := q.exec(ctx, q.deleteFriendStmt, deleteFriend, arg.UserID, arg.FriendID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteGameRoom = `-- name: DeleteGameRoom :exec
+DELETE
+FROM game_rooms
+WHERE id = ?
+`
+
+func (q *Queries) DeleteGameRoom(ctx context.Context, id string) error {
+	_, err := q.exec(ctx, q.deleteGameRoomStmt, deleteGameRoom, id)
+	return err
+}
+
+const deleteGameRoomPlayers = `-- name: DeleteGameRoomPlayers :exec
+DELETE
+FROM game_room_players
+WHERE game_room_id = ?
+`
+
+func (q *Queries) DeleteGameRoomPlayers(ctx context.Context, gameRoomID string) error {
+	_, err := q.exec(ctx, q.deleteGameRoomPlayersStmt, deleteGameRoomPlayers, gameRoomID)
+	return err
+}
+
+const deleteLoginAttempt = `-- name: DeleteLoginAttempt :exec
+DELETE
+FROM login_attempts
+WHERE scope = ?
+  AND subject = ?
+`
+
+type DeleteLoginAttemptParams struct {
+	Scope   string
+	Subject string
+}
+
+func (q *Queries) DeleteLoginAttempt(ctx context.Context, arg DeleteLoginAttemptParams) error {
+	_, err := q.exec(ctx, q.deleteLoginAttemptStmt, deleteLoginAttempt, arg.Scope, arg.Subject)
+	return err
+}
+
+const deleteMute = `-- name: DeleteMute :execrows
+DELETE
+FROM mutes
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteMute(ctx context.Context, userID int64) (int64, error) {
+	result, err := q.exec(ctx, q.deleteMuteStmt, deleteMute, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deletePasswordReset = `-- name: DeletePasswordReset :exec
+DELETE
+FROM password_resets
+WHERE user_id = ?
+`
+
+func (q *Queries) DeletePasswordReset(ctx context.Context, userID int64) error {
+	_, err := q.exec(ctx, q.deletePasswordResetStmt, deletePasswordReset, userID)
+	return err
+}
+
 const findCharacter = `-- name: FindCharacter :one
 SELECT id, user_id, character_name, strength, agility, wisdom, constitution, health_points, magic_points, experience_points, money, score_points, class_type, skin_carnation, hair_style, light_armour_legs, light_armour_torso, light_armour_hands, light_armour_boots, full_armour, armour_emblem, helmet, secondary_weapon, primary_weapon, shield, unknown_equipment_slot, gender, level, edged_weapons, blunted_weapons, archery, polearms, wizardry, holy_magic, dark_magic, bonus_points, inventory, spells
 FROM characters
@@ -263,6 +703,73 @@ func (q *Queries) FindCharacter(ctx context.Context, arg FindCharacterParams) (C
 	return i, err
 }
 
+const getActiveMute = `-- name: GetActiveMute :one
+SELECT user_id, reason, issued_by, created_at, expires_at
+FROM mutes
+WHERE user_id = ?
+  AND expires_at > ?
+LIMIT 1
+`
+
+type GetActiveMuteParams struct {
+	UserID    int64
+	ExpiresAt int64
+}
+
+func (q *Queries) GetActiveMute(ctx context.Context, arg GetActiveMuteParams) (Mute, error) {
+	row := q.queryRow(ctx, q.getActiveMuteStmt, getActiveMute, arg.UserID, arg.ExpiresAt)
+	var i Mute
+	err := row.Scan(
+		&i.UserID,
+		&i.Reason,
+		&i.IssuedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getChannelByName = `-- name: GetChannelByName :one
+SELECT id, name, description, password, max_users, min_level, motd
+FROM channels
+WHERE name = ?
+LIMIT 1
+`
+
+func (q *Queries) GetChannelByName(ctx context.Context, name string) (Channel, error) {
+	row := q.queryRow(ctx, q.getChannelByNameStmt, getChannelByName, name)
+	var i Channel
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Password,
+		&i.MaxUsers,
+		&i.MinLevel,
+		&i.Motd,
+	)
+	return i, err
+}
+
+const getCharacterLevel = `-- name: GetCharacterLevel :one
+SELECT level
+FROM characters
+WHERE id = ?
+  AND user_id = ?
+`
+
+type GetCharacterLevelParams struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) GetCharacterLevel(ctx context.Context, arg GetCharacterLevelParams) (int64, error) {
+	row := q.queryRow(ctx, q.getCharacterLevelStmt, getCharacterLevel, arg.ID, arg.UserID)
+	var level int64
+	err := row.Scan(&level)
+	return level, err
+}
+
 const getCurrentUser = `-- name: GetCurrentUser :one
 SELECT position, cte.score_points, cte.username, cte.character_name
 FROM (SELECT ROW_NUMBER() over (ORDER BY score_points) as position,
@@ -300,8 +807,93 @@ func (q *Queries) GetCurrentUser(ctx context.Context, arg GetCurrentUserParams)
 	return i, err
 }
 
+const getFriendRequest = `-- name: GetFriendRequest :one
+SELECT user_id, friend_id, accepted, created_at
+FROM friends
+WHERE user_id = ?
+  AND friend_id = ?
+`
+
+type GetFriendRequestParams struct {
+	UserID   int64
+	FriendID int64
+}
+
+func (q *Queries) GetFriendRequest(ctx context.Context, arg GetFriendRequestParams) (Friend, error) {
+	row := q.queryRow(ctx, q.getFriendRequestStmt, getFriendRequest, arg.UserID, arg.FriendID)
+	var i Friend
+	err := row.Scan(
+		&i.UserID,
+		&i.FriendID,
+		&i.Accepted,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLoginAttempt = `-- name: GetLoginAttempt :one
+SELECT scope, subject, failures, last_failure, locked_until
+FROM login_attempts
+WHERE scope = ?
+  AND subject = ?
+LIMIT 1
+`
+
+type GetLoginAttemptParams struct {
+	Scope   string
+	Subject string
+}
+
+func (q *Queries) GetLoginAttempt(ctx context.Context, arg GetLoginAttemptParams) (LoginAttempt, error) {
+	row := q.queryRow(ctx, q.getLoginAttemptStmt, getLoginAttempt, arg.Scope, arg.Subject)
+	var i LoginAttempt
+	err := row.Scan(
+		&i.Scope,
+		&i.Subject,
+		&i.Failures,
+		&i.LastFailure,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const getMatch = `-- name: GetMatch :one
+SELECT id, game_room_id, name, map_id, host_user_id, started_at, ended_at
+FROM matches
+WHERE id = ?
+`
+
+func (q *Queries) GetMatch(ctx context.Context, id int64) (Match, error) {
+	row := q.queryRow(ctx, q.getMatchStmt, getMatch, id)
+	var i Match
+	err := row.Scan(
+		&i.ID,
+		&i.GameRoomID,
+		&i.Name,
+		&i.MapID,
+		&i.HostUserID,
+		&i.StartedAt,
+		&i.EndedAt,
+	)
+	return i, err
+}
+
+const getPasswordReset = `-- name: GetPasswordReset :one
+SELECT user_id, code_hash, expires_at
+FROM password_resets
+WHERE user_id = ?
+LIMIT 1
+`
+
+func (q *Queries) GetPasswordReset(ctx context.Context, userID int64) (PasswordReset, error) {
+	row := q.queryRow(ctx, q.getPasswordResetStmt, getPasswordReset, userID)
+	var i PasswordReset
+	err := row.Scan(&i.UserID, &i.CodeHash, &i.ExpiresAt)
+	return i, err
+}
+
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, username, password
+SELECT id, username, password, role
 FROM users
 WHERE id = ?
 LIMIT 1
@@ -310,12 +902,17 @@ LIMIT 1
 func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 	row := q.queryRow(ctx, q.getUserByIDStmt, getUserByID, id)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.Password)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Password,
+		&i.Role,
+	)
 	return i, err
 }
 
 const getUserByName = `-- name: GetUserByName :one
-SELECT id, username, password
+SELECT id, username, password, role
 FROM users
 WHERE username = ?
 LIMIT 1
@@ -324,13 +921,131 @@ LIMIT 1
 func (q *Queries) GetUserByName(ctx context.Context, username string) (User, error) {
 	row := q.queryRow(ctx, q.getUserByNameStmt, getUserByName, username)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.Password)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Password,
+		&i.Role,
+	)
 	return i, err
 }
 
-const listCharacters = `-- name: ListCharacters :many
-SELECT id, user_id, character_name, strength, agility, wisdom, constitution, health_points, magic_points, experience_points, money, score_points, class_type, skin_carnation, hair_style, light_armour_legs, light_armour_torso, light_armour_hands, light_armour_boots, full_armour, armour_emblem, helmet, secondary_weapon, primary_weapon, shield, unknown_equipment_slot, gender, level, edged_weapons, blunted_weapons, archery, polearms, wizardry, holy_magic, dark_magic, bonus_points, inventory, spells
-FROM characters
+const listActiveBans = `-- name: ListActiveBans :many
+SELECT id, user_id, cidr, reason, issued_by, created_at, expires_at
+FROM bans
+WHERE expires_at IS NULL
+   OR expires_at > ?
+ORDER BY id
+`
+
+func (q *Queries) ListActiveBans(ctx context.Context, expiresAt sql.NullInt64) ([]Ban, error) {
+	rows, err := q.query(ctx, q.listActiveBansStmt, listActiveBans, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Ban
+	for rows.Next() {
+		var i Ban
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Cidr,
+			&i.Reason,
+			&i.IssuedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAnnouncements = `-- name: ListAnnouncements :many
+SELECT id, text, channel, interval_seconds, next_run_at, created_by, created_at
+FROM announcements
+ORDER BY id
+`
+
+func (q *Queries) ListAnnouncements(ctx context.Context) ([]Announcement, error) {
+	rows, err := q.query(ctx, q.listAnnouncementsStmt, listAnnouncements)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Announcement
+	for rows.Next() {
+		var i Announcement
+		if err := rows.Scan(
+			&i.ID,
+			&i.Text,
+			&i.Channel,
+			&i.IntervalSeconds,
+			&i.NextRunAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChannels = `-- name: ListChannels :many
+SELECT id, name, description, password, max_users, min_level, motd
+FROM channels
+ORDER BY id
+`
+
+func (q *Queries) ListChannels(ctx context.Context) ([]Channel, error) {
+	rows, err := q.query(ctx, q.listChannelsStmt, listChannels)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Channel
+	for rows.Next() {
+		var i Channel
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Password,
+			&i.MaxUsers,
+			&i.MinLevel,
+			&i.Motd,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCharacters = `-- name: ListCharacters :many
+SELECT id, user_id, character_name, strength, agility, wisdom, constitution, health_points, magic_points, experience_points, money, score_points, class_type, skin_carnation, hair_style, light_armour_legs, light_armour_torso, light_armour_hands, light_armour_boots, full_armour, armour_emblem, helmet, secondary_weapon, primary_weapon, shield, unknown_equipment_slot, gender, level, edged_weapons, blunted_weapons, archery, polearms, wizardry, holy_magic, dark_magic, bonus_points, inventory, spells
+FROM characters
 WHERE user_id = ?
 `
 
@@ -396,6 +1111,424 @@ func (q *Queries) ListCharacters(ctx context.Context, userID int64) ([]Character
 	return items, nil
 }
 
+const listChatMessages = `-- name: ListChatMessages :many
+SELECT id, channel, user_id, username, text, created_at
+FROM chat_messages
+WHERE channel = ?
+  AND id < ?
+ORDER BY id DESC
+LIMIT ?
+`
+
+type ListChatMessagesParams struct {
+	Channel string
+	ID      int64
+	Limit   int64
+}
+
+func (q *Queries) ListChatMessages(ctx context.Context, arg ListChatMessagesParams) ([]ChatMessage, error) {
+	rows, err := q.query(ctx, q.listChatMessagesStmt, listChatMessages, arg.Channel, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.Channel,
+			&i.UserID,
+			&i.Username,
+			&i.Text,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDueAnnouncements = `-- name: ListDueAnnouncements :many
+SELECT id, text, channel, interval_seconds, next_run_at, created_by, created_at
+FROM announcements
+WHERE next_run_at <= ?
+ORDER BY next_run_at, id
+`
+
+func (q *Queries) ListDueAnnouncements(ctx context.Context, nextRunAt int64) ([]Announcement, error) {
+	rows, err := q.query(ctx, q.listDueAnnouncementsStmt, listDueAnnouncements, nextRunAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Announcement
+	for rows.Next() {
+		var i Announcement
+		if err := rows.Scan(
+			&i.ID,
+			&i.Text,
+			&i.Channel,
+			&i.IntervalSeconds,
+			&i.NextRunAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFriendIDs = `-- name: ListFriendIDs :many
+SELECT friend_id
+FROM friends
+WHERE user_id = ?1
+  AND accepted
+UNION
+SELECT user_id
+FROM friends
+WHERE friend_id = ?1
+  AND accepted
+`
+
+func (q *Queries) ListFriendIDs(ctx context.Context, userID int64) ([]int64, error) {
+	rows, err := q.query(ctx, q.listFriendIDsStmt, listFriendIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var friend_id int64
+		if err := rows.Scan(&friend_id); err != nil {
+			return nil, err
+		}
+		items = append(items, friend_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFriends = `-- name: ListFriends :many
+SELECT users.id, users.username, friends.accepted, friends.user_id AS requested_by
+FROM friends
+         JOIN users ON users.id = friends.friend_id
+WHERE friends.user_id = ?1
+UNION ALL
+SELECT users.id, users.username, friends.accepted, friends.user_id AS requested_by
+FROM friends
+         JOIN users ON users.id = friends.user_id
+WHERE friends.friend_id = ?1
+ORDER BY username
+`
+
+type ListFriendsRow struct {
+	ID          int64
+	Username    string
+	Accepted    bool
+	RequestedBy int64
+}
+
+func (q *Queries) ListFriends(ctx context.Context, userID int64) ([]ListFriendsRow, error) {
+	rows, err := q.query(ctx, q.listFriendsStmt, listFriends, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFriendsRow
+	for rows.Next() {
+		var i ListFriendsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Accepted,
+			&i.RequestedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameRoomPlayers = `-- name: ListGameRoomPlayers :many
+SELECT game_room_id, user_id, username, character_id, class_type, ip_address, joined_at
+FROM game_room_players
+ORDER BY game_room_id, joined_at, user_id
+`
+
+func (q *Queries) ListGameRoomPlayers(ctx context.Context) ([]GameRoomPlayer, error) {
+	rows, err := q.query(ctx, q.listGameRoomPlayersStmt, listGameRoomPlayers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameRoomPlayer
+	for rows.Next() {
+		var i GameRoomPlayer
+		if err := rows.Scan(
+			&i.GameRoomID,
+			&i.UserID,
+			&i.Username,
+			&i.CharacterID,
+			&i.ClassType,
+			&i.IpAddress,
+			&i.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameRooms = `-- name: ListGameRooms :many
+SELECT id, name, password_hash, map_id, host_user_id, created_by, created_at, max_players, state
+FROM game_rooms
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListGameRooms(ctx context.Context) ([]GameRoom, error) {
+	rows, err := q.query(ctx, q.listGameRoomsStmt, listGameRooms)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameRoom
+	for rows.Next() {
+		var i GameRoom
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.PasswordHash,
+			&i.MapID,
+			&i.HostUserID,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.MaxPlayers,
+			&i.State,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchPlayers = `-- name: ListMatchPlayers :many
+SELECT id, match_id, user_id, username, character_id, class_type, joined_at, left_at, score_delta, experience_delta, money_delta
+FROM match_players
+WHERE match_id = ?
+ORDER BY joined_at, id
+`
+
+func (q *Queries) ListMatchPlayers(ctx context.Context, matchID int64) ([]MatchPlayer, error) {
+	rows, err := q.query(ctx, q.listMatchPlayersStmt, listMatchPlayers, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MatchPlayer
+	for rows.Next() {
+		var i MatchPlayer
+		if err := rows.Scan(
+			&i.ID,
+			&i.MatchID,
+			&i.UserID,
+			&i.Username,
+			&i.CharacterID,
+			&i.ClassType,
+			&i.JoinedAt,
+			&i.LeftAt,
+			&i.ScoreDelta,
+			&i.ExperienceDelta,
+			&i.MoneyDelta,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatches = `-- name: ListMatches :many
+SELECT id, game_room_id, name, map_id, host_user_id, started_at, ended_at
+FROM matches
+ORDER BY ended_at DESC, id DESC
+LIMIT ? OFFSET ?
+`
+
+type ListMatchesParams struct {
+	Limit  int64
+	Offset int64
+}
+
+func (q *Queries) ListMatches(ctx context.Context, arg ListMatchesParams) ([]Match, error) {
+	rows, err := q.query(ctx, q.listMatchesStmt, listMatches, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Match
+	for rows.Next() {
+		var i Match
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameRoomID,
+			&i.Name,
+			&i.MapID,
+			&i.HostUserID,
+			&i.StartedAt,
+			&i.EndedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listModerationLog = `-- name: ListModerationLog :many
+SELECT id, action, user_id, channel, actor, reason, created_at
+FROM moderation_log
+WHERE id < ?
+ORDER BY id DESC
+LIMIT ?
+`
+
+type ListModerationLogParams struct {
+	ID    int64
+	Limit int64
+}
+
+func (q *Queries) ListModerationLog(ctx context.Context, arg ListModerationLogParams) ([]ModerationLog, error) {
+	rows, err := q.query(ctx, q.listModerationLogStmt, listModerationLog, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ModerationLog
+	for rows.Next() {
+		var i ModerationLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.Action,
+			&i.UserID,
+			&i.Channel,
+			&i.Actor,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlayerMatches = `-- name: ListPlayerMatches :many
+SELECT id, game_room_id, name, map_id, host_user_id, started_at, ended_at
+FROM matches
+WHERE id IN (SELECT match_id
+             FROM match_players
+             WHERE user_id = ?)
+ORDER BY ended_at DESC, id DESC
+LIMIT ? OFFSET ?
+`
+
+type ListPlayerMatchesParams struct {
+	UserID int64
+	Limit  int64
+	Offset int64
+}
+
+func (q *Queries) ListPlayerMatches(ctx context.Context, arg ListPlayerMatchesParams) ([]Match, error) {
+	rows, err := q.query(ctx, q.listPlayerMatchesStmt, listPlayerMatches, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Match
+	for rows.Next() {
+		var i Match
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameRoomID,
+			&i.Name,
+			&i.MapID,
+			&i.HostUserID,
+			&i.StartedAt,
+			&i.EndedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectRanking = `-- name: SelectRanking :many
 SELECT ROW_NUMBER() over (ORDER BY score_points) as position,
        score_points,
@@ -448,6 +1581,66 @@ func (q *Queries) SelectRanking(ctx context.Context, arg SelectRankingParams) ([
 	return items, nil
 }
 
+const trimChatMessages = `-- name: TrimChatMessages :execrows
+DELETE
+FROM chat_messages
+WHERE channel = ?
+  AND id NOT IN (SELECT id
+                 FROM chat_messages
+                 WHERE channel = ?
+                 ORDER BY id DESC
+                 LIMIT ?)
+`
+
+type TrimChatMessagesParams struct {
+	Channel   string
+	Channel_2 string
+	Limit     int64
+}
+
+func (q *Queries) TrimChatMessages(ctx context.Context, arg TrimChatMessagesParams) (int64, error) {
+	result, err := q.exec(ctx, q.trimChatMessagesStmt, trimChatMessages, arg.Channel, arg.Channel_2, arg.Limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateAnnouncementNextRun = `-- name: UpdateAnnouncementNextRun :exec
+UPDATE announcements
+SET next_run_at = ?
+WHERE id = ?
+`
+
+type UpdateAnnouncementNextRunParams struct {
+	NextRunAt int64
+	ID        int64
+}
+
+func (q *Queries) UpdateAnnouncementNextRun(ctx context.Context, arg UpdateAnnouncementNextRunParams) error {
+	_, err := q.exec(ctx, q.updateAnnouncementNextRunStmt, updateAnnouncementNextRun, arg.NextRunAt, arg.ID)
+	return err
+}
+
+const updateChannelMOTD = `-- name: UpdateChannelMOTD :execrows
+UPDATE channels
+SET motd = ?
+WHERE name = ?
+`
+
+type UpdateChannelMOTDParams struct {
+	Motd string
+	Name string
+}
+
+func (q *Queries) UpdateChannelMOTD(ctx context.Context, arg UpdateChannelMOTDParams) (int64, error) {
+	result, err := q.exec(ctx, q.updateChannelMOTDStmt, updateChannelMOTD, arg.Motd, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateCharacterInventory = `-- name: UpdateCharacterInventory :exec
 UPDATE characters
 SET inventory = ?
@@ -601,3 +1794,158 @@ func (q *Queries) UpdateCharacterStats(ctx context.Context, arg UpdateCharacterS
 	)
 	return err
 }
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password = ?
+WHERE id = ?
+`
+
+type UpdateUserPasswordParams struct {
+	Password string
+	ID       int64
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.exec(ctx, q.updateUserPasswordStmt, updateUserPassword, arg.Password, arg.ID)
+	return err
+}
+
+const updateUserRole = `-- name: UpdateUserRole :execrows
+UPDATE users
+SET role = ?
+WHERE id = ?
+`
+
+type UpdateUserRoleParams struct {
+	Role string
+	ID   int64
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (int64, error) {
+	result, err := q.exec(ctx, q.updateUserRoleStmt, updateUserRole, arg.Role, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertGameRoom = `-- name: UpsertGameRoom :exec
+INSERT INTO game_rooms (id, name, password_hash, map_id, host_user_id, created_by, created_at, max_players, state)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET name          = excluded.name,
+                               password_hash = excluded.password_hash,
+                               map_id        = excluded.map_id,
+                               host_user_id  = excluded.host_user_id,
+                               max_players   = excluded.max_players,
+                               state         = excluded.state
+`
+
+type UpsertGameRoomParams struct {
+	ID           string
+	Name         string
+	PasswordHash string
+	MapID        int64
+	HostUserID   int64
+	CreatedBy    int64
+	CreatedAt    int64
+	MaxPlayers   int64
+	State        int64
+}
+
+func (q *Queries) UpsertGameRoom(ctx context.Context, arg UpsertGameRoomParams) error {
+	_, err := q.exec(ctx, q.upsertGameRoomStmt, upsertGameRoom,
+		arg.ID,
+		arg.Name,
+		arg.PasswordHash,
+		arg.MapID,
+		arg.HostUserID,
+		arg.CreatedBy,
+		arg.CreatedAt,
+		arg.MaxPlayers,
+		arg.State,
+	)
+	return err
+}
+
+const upsertLoginAttempt = `-- name: UpsertLoginAttempt :exec
+INSERT INTO login_attempts (scope, subject, failures, last_failure, locked_until)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (scope, subject) DO UPDATE SET failures     = excluded.failures,
+                                           last_failure = excluded.last_failure,
+                                           locked_until = excluded.locked_until
+`
+
+type UpsertLoginAttemptParams struct {
+	Scope       string
+	Subject     string
+	Failures    int64
+	LastFailure int64
+	LockedUntil int64
+}
+
+func (q *Queries) UpsertLoginAttempt(ctx context.Context, arg UpsertLoginAttemptParams) error {
+	_, err := q.exec(ctx, q.upsertLoginAttemptStmt, upsertLoginAttempt,
+		arg.Scope,
+		arg.Subject,
+		arg.Failures,
+		arg.LastFailure,
+		arg.LockedUntil,
+	)
+	return err
+}
+
+const upsertMute = `-- name: UpsertMute :one
+INSERT INTO mutes (user_id, reason, issued_by, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (user_id) DO UPDATE SET reason     = excluded.reason,
+                                    issued_by  = excluded.issued_by,
+                                    created_at = excluded.created_at,
+                                    expires_at = excluded.expires_at
+RETURNING user_id, reason, issued_by, created_at, expires_at
+`
+
+type UpsertMuteParams struct {
+	UserID    int64
+	Reason    string
+	IssuedBy  string
+	CreatedAt int64
+	ExpiresAt int64
+}
+
+func (q *Queries) UpsertMute(ctx context.Context, arg UpsertMuteParams) (Mute, error) {
+	row := q.queryRow(ctx, q.upsertMuteStmt, upsertMute,
+		arg.UserID,
+		arg.Reason,
+		arg.IssuedBy,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i Mute
+	err := row.Scan(
+		&i.UserID,
+		&i.Reason,
+		&i.IssuedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const upsertPasswordReset = `-- name: UpsertPasswordReset :exec
+INSERT INTO password_resets (user_id, code_hash, expires_at)
+VALUES (?, ?, ?)
+ON CONFLICT (user_id) DO UPDATE SET code_hash  = excluded.code_hash,
+                                    expires_at = excluded.expires_at
+`
+
+type UpsertPasswordResetParams struct {
+	UserID    int64
+	CodeHash  string
+	ExpiresAt int64
+}
+
+func (q *Queries) UpsertPasswordReset(ctx context.Context, arg UpsertPasswordResetParams) error {
+	_, err := q.exec(ctx, q.upsertPasswordResetStmt, upsertPasswordReset, arg.UserID, arg.CodeHash, arg.ExpiresAt)
+	return err
+}
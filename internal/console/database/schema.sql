@@ -2,7 +2,8 @@ CREATE TABLE users
 (
     id       INTEGER PRIMARY KEY,
     username TEXT NOT NULL,
-    password TEXT NOT NULL
+    password TEXT NOT NULL,
+    role     TEXT NOT NULL DEFAULT 'player' CHECK (role IN ('player', 'moderator', 'admin'))
 );
 
 CREATE TABLE characters
@@ -49,23 +50,155 @@ CREATE TABLE characters
     spells                 TEXT
 );
 
+CREATE TABLE login_attempts
+(
+    scope        TEXT    NOT NULL,
+    subject      TEXT    NOT NULL,
+    failures     INTEGER NOT NULL DEFAULT 0,
+    last_failure INTEGER NOT NULL DEFAULT 0,
+    locked_until INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (scope, subject)
+);
+
+CREATE TABLE password_resets
+(
+    user_id    INTEGER PRIMARY KEY,
+    code_hash  TEXT    NOT NULL,
+    expires_at INTEGER NOT NULL
+);
+
+CREATE TABLE bans
+(
+    id         INTEGER PRIMARY KEY,
+    user_id    INTEGER,
+    cidr       TEXT,
+    reason     TEXT    NOT NULL DEFAULT '',
+    issued_by  TEXT    NOT NULL,
+    created_at INTEGER NOT NULL,
+    expires_at INTEGER,
+    CHECK ((user_id IS NULL) != (cidr IS NULL))
+);
+
+CREATE TABLE channels
+(
+    id          INTEGER PRIMARY KEY,
+    name        TEXT    NOT NULL UNIQUE,
+    description TEXT    NOT NULL DEFAULT '',
+    password    TEXT,
+    max_users   INTEGER NOT NULL DEFAULT 0,
+    min_level   INTEGER NOT NULL DEFAULT 0,
+    motd        TEXT    NOT NULL DEFAULT ''
+);
+
+CREATE TABLE chat_messages
+(
+    id         INTEGER PRIMARY KEY,
+    channel    TEXT    NOT NULL,
+    user_id    INTEGER NOT NULL,
+    username   TEXT    NOT NULL,
+    text       TEXT    NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX chat_messages_channel_id ON chat_messages (channel, id);
+
+CREATE TABLE mutes
+(
+    user_id    INTEGER PRIMARY KEY,
+    reason     TEXT    NOT NULL DEFAULT '',
+    issued_by  TEXT    NOT NULL,
+    created_at INTEGER NOT NULL,
+    expires_at INTEGER NOT NULL
+);
+
+CREATE TABLE moderation_log
+(
+    id         INTEGER PRIMARY KEY,
+    action     TEXT    NOT NULL,
+    user_id    INTEGER NOT NULL,
+    channel    TEXT    NOT NULL DEFAULT '',
+    actor      TEXT    NOT NULL,
+    reason     TEXT    NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL
+);
+
+CREATE TABLE friends
+(
+    user_id    INTEGER NOT NULL,
+    friend_id  INTEGER NOT NULL,
+    accepted   BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (user_id, friend_id),
+    CHECK (user_id != friend_id)
+);
+
+CREATE INDEX friends_friend_id ON friends (friend_id);
+
+CREATE TABLE announcements
+(
+    id               INTEGER PRIMARY KEY,
+    text             TEXT    NOT NULL,
+    channel          TEXT    NOT NULL DEFAULT '',
+    interval_seconds INTEGER NOT NULL DEFAULT 0,
+    next_run_at      INTEGER NOT NULL,
+    created_by       TEXT    NOT NULL,
+    created_at       INTEGER NOT NULL
+);
+
+CREATE INDEX announcements_next_run_at ON announcements (next_run_at);
+
 CREATE TABLE game_rooms
 (
-    id              INTEGER PRIMARY KEY,
-    name            TEXT    NOT NULL,
-    password        TEXT,
-    host_ip_address TEXT    NOT NULL,
-    map_id          INTEGER NOT NULL,
-    created_by      INTEGER NOT NULL,
-    host_user_id    INTEGER NOT NULL
+    id            TEXT PRIMARY KEY,
+    name          TEXT    NOT NULL,
+    password_hash TEXT    NOT NULL DEFAULT '',
+    map_id        INTEGER NOT NULL,
+    host_user_id  INTEGER NOT NULL,
+    created_by    INTEGER NOT NULL,
+    created_at    INTEGER NOT NULL,
+    max_players   INTEGER NOT NULL DEFAULT 4,
+    state         INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE TABLE game_room_players
 (
-    game_room_id INTEGER NOT NULL,
+    game_room_id TEXT    NOT NULL,
     user_id      INTEGER NOT NULL,
+    username     TEXT    NOT NULL,
     character_id INTEGER NOT NULL,
+    class_type   INTEGER NOT NULL,
     ip_address   TEXT    NOT NULL,
-    added_at     INTEGER NOT NULL
+    joined_at    INTEGER NOT NULL,
+    PRIMARY KEY (game_room_id, user_id)
+);
+
+CREATE TABLE matches
+(
+    id           INTEGER PRIMARY KEY,
+    game_room_id TEXT    NOT NULL,
+    name         TEXT    NOT NULL,
+    map_id       INTEGER NOT NULL,
+    host_user_id INTEGER NOT NULL,
+    started_at   INTEGER NOT NULL,
+    ended_at     INTEGER NOT NULL
+);
+
+CREATE INDEX matches_ended_at ON matches (ended_at);
+
+CREATE TABLE match_players
+(
+    id               INTEGER PRIMARY KEY,
+    match_id         INTEGER NOT NULL,
+    user_id          INTEGER NOT NULL,
+    username         TEXT    NOT NULL,
+    character_id     INTEGER NOT NULL,
+    class_type       INTEGER NOT NULL,
+    joined_at        INTEGER NOT NULL,
+    left_at          INTEGER NOT NULL,
+    score_delta      INTEGER NOT NULL DEFAULT 0,
+    experience_delta INTEGER NOT NULL DEFAULT 0,
+    money_delta      INTEGER NOT NULL DEFAULT 0
 );
 
+CREATE INDEX match_players_match_id ON match_players (match_id);
+CREATE INDEX match_players_user_id ON match_players (user_id, match_id);
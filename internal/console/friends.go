@@ -0,0 +1,216 @@
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
+	"github.com/dimspell/gladiator/internal/console/database"
+)
+
+var (
+	ErrFriendSelf     = errors.New("cannot be friends with yourself")
+	ErrFriendNotFound = errors.New("user is not a friend")
+)
+
+// FriendList stores the friendships between the users. A friendship starts as
+// a request of one user and is accepted once the other user adds the first one
+// too.
+type FriendList struct {
+	DB *database.SQLite
+
+	now func() time.Time
+}
+
+func NewFriendList(db *database.SQLite, now func() time.Time) *FriendList {
+	return &FriendList{DB: db, now: now}
+}
+
+// Add asks the other user to be friends, or accepts the request when the other
+// user has asked first. It reports whether the friendship is accepted and
+// whether anything has changed, so the other user is not notified again when
+// the request is repeated.
+func (f *FriendList) Add(ctx context.Context, userID, friendID int64) (accepted, changed bool, err error) {
+	if userID == friendID {
+		return false, false, ErrFriendSelf
+	}
+
+	pending, err := f.DB.Read.GetFriendRequest(ctx, database.GetFriendRequestParams{
+		UserID:   friendID,
+		FriendID: userID,
+	})
+	switch {
+	case err == nil:
+		if pending.Accepted {
+			return true, false, nil
+		}
+		updated, err := f.DB.Write.AcceptFriendRequest(ctx, database.AcceptFriendRequestParams{
+			UserID:   pending.UserID,
+			FriendID: pending.FriendID,
+		})
+		if err != nil {
+			return false, false, err
+		}
+		return true, updated > 0, nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return false, false, err
+	}
+
+	inserted, err := f.DB.Write.CreateFriendRequest(ctx, database.CreateFriendRequestParams{
+		UserID:    userID,
+		FriendID:  friendID,
+		CreatedAt: f.now().Unix(),
+	})
+	if err != nil {
+		return false, false, err
+	}
+	request, err := f.DB.Read.GetFriendRequest(ctx, database.GetFriendRequestParams{
+		UserID:   userID,
+		FriendID: friendID,
+	})
+	if err != nil {
+		return false, false, err
+	}
+	return request.Accepted, inserted > 0, nil
+}
+
+// Remove ends the friendship, or cancels the pending request in any direction.
+func (f *FriendList) Remove(ctx context.Context, userID, friendID int64) error {
+	deleted, err := f.DB.Write.DeleteFriend(ctx, database.DeleteFriendParams{
+		UserID:   userID,
+		FriendID: friendID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrFriendNotFound
+	}
+	return nil
+}
+
+// List returns the friends of the user together with the pending requests,
+// ordered by the username.
+func (f *FriendList) List(ctx context.Context, userID int64) ([]database.ListFriendsRow, error) {
+	return f.DB.Read.ListFriends(ctx, userID)
+}
+
+// FriendIDs returns the IDs of the users who have accepted the friendship.
+// There are no friends when the list is nil.
+func (f *FriendList) FriendIDs(ctx context.Context, userID int64) ([]int64, error) {
+	if f == nil {
+		return nil, nil
+	}
+	return f.DB.Read.ListFriendIDs(ctx, userID)
+}
+
+// notifyFriendRequest tells the user, if connected to the lobby, that another
+// user has asked to be friends or has accepted the request.
+func notifyFriendRequest(ctx context.Context, mp *Multiplayer, username string, friendID int64, accepted bool) {
+	session, found := mp.GetUserSession(friendID)
+	if !found {
+		return
+	}
+	text := fmt.Sprintf("%s wants to be your friend", username)
+	if accepted {
+		text = fmt.Sprintf("%s is now your friend", username)
+	}
+	mp.SendSystemMessage(ctx, session, text)
+}
+
+var _ multiv1connect.SocialServiceHandler = (*socialServiceServer)(nil)
+
+// socialServiceServer manages the friends of the authenticated user.
+type socialServiceServer struct {
+	DB          *database.SQLite
+	Friends     *FriendList
+	Multiplayer *Multiplayer
+}
+
+// AddFriend asks the user to be friends or accepts its request. The other
+// user is notified when connected to the lobby.
+func (s *socialServiceServer) AddFriend(ctx context.Context, req *connect.Request[multiv1.AddFriendRequest]) (*connect.Response[multiv1.AddFriendResponse], error) {
+	userID, ok := AuthUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+	user, err := s.DB.Read.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	friend, err := s.DB.Read.GetUserByName(ctx, req.Msg.Username)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("user %q not found", req.Msg.Username))
+	}
+
+	accepted, changed, err := s.Friends.Add(ctx, userID, friend.ID)
+	if err != nil {
+		if errors.Is(err, ErrFriendSelf) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if changed {
+		notifyFriendRequest(ctx, s.Multiplayer, user.Username, friend.ID, accepted)
+	}
+	return connect.NewResponse(&multiv1.AddFriendResponse{Accepted: accepted}), nil
+}
+
+// RemoveFriend ends the friendship or cancels the friend request.
+func (s *socialServiceServer) RemoveFriend(ctx context.Context, req *connect.Request[multiv1.RemoveFriendRequest]) (*connect.Response[multiv1.RemoveFriendResponse], error) {
+	userID, ok := AuthUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+	friend, err := s.DB.Read.GetUserByName(ctx, req.Msg.Username)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("user %q not found", req.Msg.Username))
+	}
+
+	if err := s.Friends.Remove(ctx, userID, friend.ID); err != nil {
+		if errors.Is(err, ErrFriendNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&multiv1.RemoveFriendResponse{}), nil
+}
+
+// ListFriends returns the friends of the user with their presence in the
+// lobby, and the pending friend requests.
+func (s *socialServiceServer) ListFriends(ctx context.Context, _ *connect.Request[multiv1.ListFriendsRequest]) (*connect.Response[multiv1.ListFriendsResponse], error) {
+	userID, ok := AuthUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	friends, err := s.Friends.List(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &multiv1.ListFriendsResponse{}
+	for _, friend := range friends {
+		switch {
+		case friend.Accepted:
+			item := &multiv1.Friend{UserId: friend.ID, Username: friend.Username}
+			if presence, found := s.Multiplayer.GetUserPresence(friend.ID); found {
+				item.Online = true
+				item.Channel = presence.Channel
+				item.GameRoomId = presence.GameID
+			}
+			resp.Friends = append(resp.Friends, item)
+		case friend.RequestedBy == userID:
+			resp.Outgoing = append(resp.Outgoing, &multiv1.PendingFriend{UserId: friend.ID, Username: friend.Username})
+		default:
+			resp.Incoming = append(resp.Incoming, &multiv1.PendingFriend{UserId: friend.ID, Username: friend.Username})
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,221 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFriendList(t *testing.T) {
+	setup := func(t *testing.T) (*FriendList, []int64) {
+		t.Helper()
+		db := setupDatabase(t)
+		var ids []int64
+		for _, username := range []string{"archer", "mage", "knight"} {
+			user, err := db.Write.CreateUser(t.Context(), database.CreateUserParams{Username: username, Password: "x"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, user.ID)
+		}
+		return NewFriendList(db, time.Now), ids
+	}
+
+	t.Run("friendship is accepted when both users add each other", func(t *testing.T) {
+		friends, ids := setup(t)
+		archer, mage := ids[0], ids[1]
+
+		accepted, changed, err := friends.Add(t.Context(), archer, mage)
+		assert.NoError(t, err)
+		assert.False(t, accepted)
+		assert.True(t, changed)
+
+		accepted, changed, err = friends.Add(t.Context(), archer, mage)
+		assert.NoError(t, err)
+		assert.False(t, accepted, "asking again does not accept the request")
+		assert.False(t, changed)
+
+		list, err := friends.List(t.Context(), mage)
+		assert.NoError(t, err)
+		assert.Equal(t, []database.ListFriendsRow{{ID: archer, Username: "archer", RequestedBy: archer}}, list)
+
+		friendIDs, err := friends.FriendIDs(t.Context(), archer)
+		assert.NoError(t, err)
+		assert.Empty(t, friendIDs)
+
+		accepted, changed, err = friends.Add(t.Context(), mage, archer)
+		assert.NoError(t, err)
+		assert.True(t, accepted)
+		assert.True(t, changed)
+
+		for _, pair := range [][2]int64{{mage, archer}, {archer, mage}} {
+			accepted, changed, err = friends.Add(t.Context(), pair[0], pair[1])
+			assert.NoError(t, err)
+			assert.True(t, accepted)
+			assert.False(t, changed, "the friendship is already accepted")
+		}
+
+		friendIDs, err = friends.FriendIDs(t.Context(), archer)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{mage}, friendIDs)
+		friendIDs, err = friends.FriendIDs(t.Context(), mage)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{archer}, friendIDs)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		friends, ids := setup(t)
+		archer, mage, knight := ids[0], ids[1], ids[2]
+
+		_, _, err := friends.Add(t.Context(), archer, mage)
+		assert.NoError(t, err)
+		_, _, err = friends.Add(t.Context(), knight, archer)
+		assert.NoError(t, err)
+
+		assert.NoError(t, friends.Remove(t.Context(), mage, archer))
+		assert.ErrorIs(t, friends.Remove(t.Context(), mage, archer), ErrFriendNotFound)
+
+		list, err := friends.List(t.Context(), archer)
+		assert.NoError(t, err)
+		assert.Equal(t, []database.ListFriendsRow{{ID: knight, Username: "knight", RequestedBy: knight}}, list)
+	})
+
+	t.Run("cannot befriend yourself", func(t *testing.T) {
+		friends, ids := setup(t)
+		_, _, err := friends.Add(t.Context(), ids[0], ids[0])
+		assert.ErrorIs(t, err, ErrFriendSelf)
+	})
+}
+
+func TestSocialServiceServer(t *testing.T) {
+	db := setupDatabase(t)
+	archer, err := db.Write.CreateUser(t.Context(), database.CreateUserParams{Username: "archer", Password: "x"})
+	assert.NoError(t, err)
+	mage, err := db.Write.CreateUser(t.Context(), database.CreateUserParams{Username: "mage", Password: "x"})
+	assert.NoError(t, err)
+
+	mp := NewMultiplayer()
+	mp.Friends = NewFriendList(db, time.Now)
+	s := &socialServiceServer{DB: db, Friends: mp.Friends, Multiplayer: mp}
+
+	conn := &recordingConn{}
+	session := NewUserSession(mage.ID, conn)
+	session.User = wire.User{UserID: mage.ID, Username: "mage"}
+	session.Channel = "DISPEL"
+	mp.AddUserSession(mage.ID, session)
+
+	asArcher := withAuthUserID(t.Context(), archer.ID)
+	asMage := withAuthUserID(t.Context(), mage.ID)
+
+	_, err = s.AddFriend(t.Context(), connect.NewRequest(&multiv1.AddFriendRequest{Username: "mage"}))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	_, err = s.AddFriend(asArcher, connect.NewRequest(&multiv1.AddFriendRequest{Username: "nobody"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = s.AddFriend(asArcher, connect.NewRequest(&multiv1.AddFriendRequest{Username: "archer"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	added, err := s.AddFriend(asArcher, connect.NewRequest(&multiv1.AddFriendRequest{Username: "mage"}))
+	if assert.NoError(t, err) {
+		assert.False(t, added.Msg.Accepted)
+	}
+	if assert.Len(t, conn.written, 1) {
+		_, msg, err := wire.DecodeTyped[wire.ChatMessage](conn.written[0])
+		assert.NoError(t, err)
+		assert.Equal(t, "archer wants to be your friend", msg.Content.Text)
+	}
+
+	_, err = s.AddFriend(asArcher, connect.NewRequest(&multiv1.AddFriendRequest{Username: "mage"}))
+	assert.NoError(t, err)
+	assert.Len(t, conn.written, 1, "the repeated request is not notified")
+
+	list, err := s.ListFriends(asMage, connect.NewRequest(&multiv1.ListFriendsRequest{}))
+	if assert.NoError(t, err) {
+		assert.Empty(t, list.Msg.Friends)
+		assert.Empty(t, list.Msg.Outgoing)
+		if assert.Len(t, list.Msg.Incoming, 1) {
+			assert.Equal(t, "archer", list.Msg.Incoming[0].Username)
+		}
+	}
+
+	added, err = s.AddFriend(asMage, connect.NewRequest(&multiv1.AddFriendRequest{Username: "archer"}))
+	if assert.NoError(t, err) {
+		assert.True(t, added.Msg.Accepted)
+	}
+
+	list, err = s.ListFriends(asArcher, connect.NewRequest(&multiv1.ListFriendsRequest{}))
+	if assert.NoError(t, err) && assert.Len(t, list.Msg.Friends, 1) {
+		assert.Equal(t, "mage", list.Msg.Friends[0].Username)
+		assert.True(t, list.Msg.Friends[0].Online)
+		assert.Equal(t, "DISPEL", list.Msg.Friends[0].Channel)
+	}
+
+	// The friend creates a game room, while the list is read.
+	created := make(chan struct{})
+	go func() {
+		defer close(created)
+		_, err := mp.CreateRoom(mage.ID, "room", "", multiv1.GameMap_AbandonedRealm, "10.0.0.2", 0)
+		assert.NoError(t, err)
+	}()
+	_, err = s.ListFriends(asArcher, connect.NewRequest(&multiv1.ListFriendsRequest{}))
+	assert.NoError(t, err)
+	<-created
+
+	list, err = s.ListFriends(asArcher, connect.NewRequest(&multiv1.ListFriendsRequest{}))
+	if assert.NoError(t, err) && assert.Len(t, list.Msg.Friends, 1) {
+		assert.Equal(t, "room", list.Msg.Friends[0].GameRoomId)
+	}
+
+	_, err = s.RemoveFriend(asArcher, connect.NewRequest(&multiv1.RemoveFriendRequest{Username: "mage"}))
+	assert.NoError(t, err)
+	_, err = s.RemoveFriend(asArcher, connect.NewRequest(&multiv1.RemoveFriendRequest{Username: "mage"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestMultiplayer_NotifyFriends(t *testing.T) {
+	db := setupDatabase(t)
+	mp := NewMultiplayer()
+	mp.Friends = NewFriendList(db, time.Now)
+
+	var ids []int64
+	for _, username := range []string{"archer", "mage", "knight"} {
+		user, err := db.Write.CreateUser(t.Context(), database.CreateUserParams{Username: username, Password: "x"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, user.ID)
+	}
+	archer, mage, knight := ids[0], ids[1], ids[2]
+	for _, pair := range [][2]int64{{archer, mage}, {mage, archer}, {knight, archer}} {
+		_, _, err := mp.Friends.Add(t.Context(), pair[0], pair[1])
+		assert.NoError(t, err)
+	}
+
+	mageConn := &recordingConn{}
+	mp.AddUserSession(mage, NewUserSession(mage, mageConn))
+	knightConn := &recordingConn{}
+	mp.AddUserSession(knight, NewUserSession(knight, knightConn))
+
+	session := NewUserSession(archer, &recordingConn{})
+	session.User = wire.User{UserID: archer, Username: "archer"}
+	mp.SetPlayerConnected(session)
+
+	var texts []string
+	for _, payload := range mageConn.written {
+		if et, msg, err := wire.DecodeTyped[wire.ChatMessage](payload); err == nil && et == wire.SystemMessage {
+			texts = append(texts, msg.Content.Text)
+		}
+	}
+	assert.Equal(t, []string{"Your friend archer is online"}, texts)
+
+	// The friend request has not been accepted yet.
+	for _, payload := range knightConn.written {
+		assert.NotEqual(t, wire.SystemMessage, wire.ParseEventType(payload))
+	}
+}
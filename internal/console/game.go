@@ -2,6 +2,7 @@ package console
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -17,19 +18,27 @@ type gameServiceServer struct {
 	Multiplayer *Multiplayer
 }
 
-// ListGames returns a list of all open games.
+// ListGames returns a list of all open games. The games, which cannot be
+// joined, are not listed.
 func (s *gameServiceServer) ListGames(_ context.Context, req *connect.Request[multiv1.ListGamesRequest]) (*connect.Response[multiv1.ListGamesResponse], error) {
 	rooms := s.Multiplayer.ListRooms()
 
 	games := make([]*multiv1.Game, 0, len(rooms))
 	for _, room := range rooms {
+		if !room.Joinable() {
+			continue
+		}
+		hostUserID, hostIPAddress := room.HostAddress()
 		games = append(games, &multiv1.Game{
 			GameId:        room.ID,
 			Name:          room.Name,
-			Password:      room.Password,
 			MapId:         room.MapID,
-			HostUserId:    room.HostPlayer.UserID,
-			HostIpAddress: room.HostPlayer.IPAddress,
+			HostUserId:    hostUserID,
+			HostIpAddress: hostIPAddress,
+			PlayerCount:   int32(len(room.Players)),
+			MaxPlayers:    int32(room.MaxPlayers),
+			HasPassword:   room.HasPassword(),
+			State:         room.State,
 		})
 	}
 
@@ -54,14 +63,18 @@ func (s *gameServiceServer) GetGame(_ context.Context, req *connect.Request[mult
 			IpAddress:   player.IPAddress,
 		})
 	}
+	hostUserID, hostIPAddress := room.HostAddress()
 	resp := connect.NewResponse(&multiv1.GetGameResponse{
 		Game: &multiv1.Game{
 			GameId:        room.ID,
 			Name:          room.Name,
-			Password:      room.Password,
 			MapId:         room.MapID,
-			HostUserId:    room.HostPlayer.UserID,
-			HostIpAddress: room.HostPlayer.IPAddress,
+			HostUserId:    hostUserID,
+			HostIpAddress: hostIPAddress,
+			PlayerCount:   int32(len(room.Players)),
+			MaxPlayers:    int32(room.MaxPlayers),
+			HasPassword:   room.HasPassword(),
+			State:         room.State,
 		},
 		Players: players,
 	})
@@ -78,22 +91,30 @@ func (s *gameServiceServer) CreateGame(_ context.Context, req *connect.Request[m
 		req.Msg.Password,
 		req.Msg.MapId,
 		req.Msg.HostIpAddress,
+		int(req.Msg.MaxPlayers),
 	)
+	if errors.Is(err, ErrInvalidMaxPlayers) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	if err != nil {
 		slog.With(slog.String("game", req.Msg.GameName), logging.Error(err)).Warn("Create room failed")
 		return nil, connect.NewError(connect.CodeAborted, fmt.Errorf("create-game failed"))
 	}
 
 	slog.Debug("Created new room", "gameId", gameId)
+	s.Multiplayer.AnnounceRoomCreated(room)
 
 	resp := connect.NewResponse(&multiv1.CreateGameResponse{
 		Game: &multiv1.Game{
 			GameId:        room.ID,
 			Name:          room.Name,
-			Password:      room.Password,
 			MapId:         room.MapID,
 			HostUserId:    room.HostPlayer.UserID,
 			HostIpAddress: room.HostPlayer.IPAddress,
+			PlayerCount:   int32(len(room.Players)),
+			MaxPlayers:    int32(room.MaxPlayers),
+			HasPassword:   room.HasPassword(),
+			State:         room.State,
 		},
 	})
 	return resp, nil
@@ -106,6 +127,15 @@ func (s *gameServiceServer) JoinGame(_ context.Context, req *connect.Request[mul
 		req.Msg.UserId,
 		req.Msg.IpAddress,
 	)
+	if errors.Is(err, ErrKickedFromRoom) {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if errors.Is(err, ErrRoomFull) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if errors.Is(err, ErrRoomNotJoinable) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
 	if err != nil {
 		slog.Error("failed to join room", "gameId", req.Msg.GameRoomId, logging.Error(err))
 		return nil, connect.NewError(connect.CodeAborted, err)
@@ -127,3 +157,49 @@ func (s *gameServiceServer) JoinGame(_ context.Context, req *connect.Request[mul
 	resp := connect.NewResponse(&multiv1.JoinGameResponse{Players: players})
 	return resp, nil
 }
+
+// SetGameState starts or opens again the game on demand of its host.
+func (s *gameServiceServer) SetGameState(_ context.Context, req *connect.Request[multiv1.SetGameStateRequest]) (*connect.Response[multiv1.SetGameStateResponse], error) {
+	room, err := s.Multiplayer.SetRoomState(req.Msg.GameRoomId, req.Msg.HostUserId, req.Msg.State)
+	switch {
+	case errors.Is(err, ErrRoomNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrNotRoomHost):
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, ErrInvalidRoomState):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := connect.NewResponse(&multiv1.SetGameStateResponse{
+		Game: &multiv1.Game{
+			GameId:        room.ID,
+			Name:          room.Name,
+			MapId:         room.MapID,
+			HostUserId:    room.HostPlayer.UserID,
+			HostIpAddress: room.HostPlayer.IPAddress,
+			PlayerCount:   int32(len(room.Players)),
+			MaxPlayers:    int32(room.MaxPlayers),
+			HasPassword:   room.HasPassword(),
+			State:         room.State,
+		},
+	})
+	return resp, nil
+}
+
+// KickPlayer removes the player from the game on demand of its host.
+func (s *gameServiceServer) KickPlayer(ctx context.Context, req *connect.Request[multiv1.KickPlayerRequest]) (*connect.Response[multiv1.KickPlayerResponse], error) {
+	err := s.Multiplayer.KickFromRoom(ctx, req.Msg.GameRoomId, req.Msg.HostUserId, req.Msg.PlayerUserId)
+	switch {
+	case errors.Is(err, ErrRoomNotFound), errors.Is(err, ErrNotRoomMember):
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrNotRoomHost):
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, ErrCannotKickHost):
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&multiv1.KickPlayerResponse{}), nil
+}
@@ -8,7 +8,6 @@ import (
 	"connectrpc.com/connect"
 	"github.com/coder/websocket"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
-	"github.com/dimspell/gladiator/internal/wire"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,6 +17,7 @@ func (m *mockConn) Read(ctx context.Context) (websocket.MessageType, []byte, err
 	return websocket.MessageText, []byte{}, nil
 }
 func (m *mockConn) Write(ctx context.Context, typ websocket.MessageType, p []byte) error { return nil }
+func (m *mockConn) Close(code websocket.StatusCode, reason string) error                 { return nil }
 func (m *mockConn) CloseNow() error                                                      { return nil }
 
 func TestGameServiceServer_CreateGame(t *testing.T) {
@@ -55,10 +55,13 @@ func TestGameServiceServer_CreateGame(t *testing.T) {
 			return
 		}
 
-		assert.Equal(t, false, room.Ready)
+		assert.Equal(t, multiv1.GameState_GameStateCreating, room.State)
 		assert.Equal(t, gameId, room.ID)
 		assert.Equal(t, gameId, room.Name)
-		assert.Equal(t, "secret", room.Password)
+		assert.True(t, room.HasPassword())
+		assert.NotEqual(t, "secret", room.PasswordHash)
+		assert.True(t, room.CheckPassword("secret"))
+		assert.False(t, room.CheckPassword("wrong"))
 		assert.Equal(t, multiv1.GameMap_FrozenLabyrinth, room.MapID)
 
 		assert.Equal(t, int64(10), room.HostPlayer.UserID)
@@ -114,6 +117,7 @@ func TestGameServiceServer_ListGames(t *testing.T) {
 		t.Error(err)
 		return
 	}
+	openRoom(t, g.Multiplayer, gameId)
 
 	resp, err := g.ListGames(context.Background(), connect.NewRequest(&multiv1.ListGamesRequest{}))
 	if err != nil {
@@ -130,7 +134,8 @@ func TestGameServiceServer_ListGames(t *testing.T) {
 	room := games[0]
 	assert.Equal(t, gameId, room.GameId)
 	assert.Equal(t, gameId, room.Name)
-	assert.Equal(t, "secret", room.Password)
+	assert.Empty(t, room.Password, "the password is never returned")
+	assert.True(t, room.HasPassword)
 	assert.Equal(t, multiv1.GameMap_FrozenLabyrinth, room.MapId)
 	assert.Equal(t, "192.168.100.1", room.HostIpAddress)
 
@@ -167,7 +172,8 @@ func TestGameServiceServer_GetGame(t *testing.T) {
 	room := resp.Msg.GetGame()
 	assert.Equal(t, gameId, room.GameId)
 	assert.Equal(t, gameId, room.Name)
-	assert.Equal(t, "secret", room.Password)
+	assert.Empty(t, room.Password, "the password is never returned")
+	assert.True(t, room.HasPassword)
 	assert.Equal(t, multiv1.GameMap_FrozenLabyrinth, room.MapId)
 	assert.Equal(t, int64(10), room.HostUserId)
 	assert.Equal(t, "192.168.100.1", room.HostIpAddress)
@@ -197,6 +203,7 @@ func TestGameServiceServer_JoinGame(t *testing.T) {
 			t.Error(err)
 			return
 		}
+		openRoom(t, g.Multiplayer, roomID)
 
 		resp, err := g.JoinGame(context.Background(), connect.NewRequest(&multiv1.JoinGameRequest{
 			UserId:     5,
@@ -244,10 +251,7 @@ func TestGameServiceServer_JoinGame(t *testing.T) {
 			t.Error(err)
 			return
 		}
-		g.Multiplayer.SetRoomReady(wire.Message{
-			Type:    wire.SetRoomReady,
-			Content: roomID,
-		})
+		openRoom(t, g.Multiplayer, roomID)
 
 		resp1, err := g.JoinGame(t.Context(), connect.NewRequest(&multiv1.JoinGameRequest{
 			UserId:     5,
@@ -278,4 +282,93 @@ func TestGameServiceServer_JoinGame(t *testing.T) {
 		assert.Equal(t, 2, len(resp2.Msg.GetPlayers()))
 		assert.Equal(t, 2, len(g.Multiplayer.Rooms[roomID].Players))
 	})
+	t.Run("room is full", func(t *testing.T) {
+		roomID := "testing"
+		g := &gameServiceServer{
+			Multiplayer: NewMultiplayer(),
+		}
+		for _, id := range []int64{10, 5, 6} {
+			g.Multiplayer.AddUserSession(id, NewUserSession(id, &mockConn{}))
+		}
+
+		resp, err := g.CreateGame(t.Context(), connect.NewRequest(&multiv1.CreateGameRequest{
+			GameName:      roomID,
+			MapId:         multiv1.GameMap_FrozenLabyrinth,
+			MaxPlayers:    2,
+			HostIpAddress: "192.168.100.1",
+			HostUserId:    10,
+		}))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		assert.Equal(t, int32(1), resp.Msg.Game.PlayerCount)
+		assert.Equal(t, int32(2), resp.Msg.Game.MaxPlayers)
+		openRoom(t, g.Multiplayer, roomID)
+
+		_, err = g.JoinGame(t.Context(), connect.NewRequest(&multiv1.JoinGameRequest{
+			UserId:     5,
+			GameRoomId: roomID,
+			IpAddress:  "192.168.100.201",
+		}))
+		assert.NoError(t, err)
+
+		_, err = g.JoinGame(t.Context(), connect.NewRequest(&multiv1.JoinGameRequest{
+			UserId:     6,
+			GameRoomId: roomID,
+			IpAddress:  "192.168.100.202",
+		}))
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+		assert.ErrorIs(t, err, ErrRoomFull)
+		assert.Len(t, g.Multiplayer.Rooms[roomID].Players, 2)
+
+		game, err := g.GetGame(t.Context(), connect.NewRequest(&multiv1.GetGameRequest{GameRoomId: roomID}))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), game.Msg.Game.PlayerCount)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		g := &gameServiceServer{
+			Multiplayer: NewMultiplayer(),
+		}
+		g.Multiplayer.AddUserSession(10, NewUserSession(10, &mockConn{}))
+
+		_, err := g.CreateGame(t.Context(), connect.NewRequest(&multiv1.CreateGameRequest{
+			GameName:   "testing",
+			MaxPlayers: 5,
+			HostUserId: 10,
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		assert.Empty(t, g.Multiplayer.Rooms)
+	})
+	t.Run("password is not verified yet", func(t *testing.T) {
+		roomID := "testing"
+		g := &gameServiceServer{
+			Multiplayer: NewMultiplayer(),
+		}
+		g.Multiplayer.AddUserSession(10, NewUserSession(10, &mockConn{}))
+		g.Multiplayer.AddUserSession(5, NewUserSession(5, &mockConn{}))
+
+		if _, err := g.CreateGame(t.Context(), connect.NewRequest(&multiv1.CreateGameRequest{
+			GameName:      roomID,
+			Password:      "secret",
+			MapId:         multiv1.GameMap_FrozenLabyrinth,
+			HostIpAddress: "192.168.100.1",
+			HostUserId:    10,
+		})); err != nil {
+			t.Error(err)
+			return
+		}
+		openRoom(t, g.Multiplayer, roomID)
+
+		// The protected rooms must not be locked, as long as the password
+		// typed by the player cannot be read from the game.
+		_, err := g.JoinGame(t.Context(), connect.NewRequest(&multiv1.JoinGameRequest{
+			UserId:     5,
+			GameRoomId: roomID,
+			IpAddress:  "192.168.100.201",
+		}))
+		assert.NoError(t, err)
+		assert.Len(t, g.Multiplayer.Rooms[roomID].Players, 2)
+	})
 }
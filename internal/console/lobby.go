@@ -1,6 +1,7 @@
 package console
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -20,9 +21,7 @@ func (c *Console) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 	}
 	// version := params.Get("version")
 
-	// FIXME: Improve validation.
-	// For now only the DISPEL channel is available to use.
-	if channelName != "DISPEL" || userID == 0 {
+	if userID == 0 {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
@@ -33,6 +32,59 @@ func (c *Console) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	authUserID, err := authenticate(c.Sessions, r.Header)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if authUserID != userID {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	ip := remoteIP(r.RemoteAddr)
+	if err := c.Bans.Check(r.Context(), userID, ip); err != nil {
+		var banErr *BanError
+		if errors.As(err, &banErr) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		slog.Error("Could not check the bans", logging.Error(err), "userId", userID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// The name and the role of the user are read from the database, so the
+	// client cannot pretend to be someone else in the lobby.
+	user, err := getUser(r.Context(), c.DB, userID)
+	if err != nil {
+		if errors.Is(err, errUnknownUser) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		slog.Error("Could not read the user", logging.Error(err), "userId", userID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	role := wire.Role(user.Role)
+
+	// The user enters the default channel, unless another one is requested.
+	channel, err := c.Multiplayer.Channels.Get(r.Context(), channelName)
+	if err != nil {
+		if errors.Is(err, ErrChannelNotFound) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		slog.Error("Could not read the channel", logging.Error(err), "channelName", channelName)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	members := c.Multiplayer.CountChannelSessions(channel.Name)
+	if err := c.Multiplayer.Channels.Admit(channel, role, members, 0, ""); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
 	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
 		Subprotocols: []string{wire.SupportedRealm},
 	})
@@ -51,7 +103,11 @@ func (c *Console) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := c.Multiplayer.HandleSession(r.Context(), NewUserSession(userID, conn)); err != nil {
+	session := NewUserSession(userID, conn)
+	session.RemoteIP = ip
+	session.User = wire.User{UserID: userID, Username: user.Username, Role: role}
+	session.Channel = channel.Name
+	if err := c.Multiplayer.HandleSession(r.Context(), session); err != nil {
 		return
 	}
 }
@@ -0,0 +1,169 @@
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/metrics"
+)
+
+const (
+	loginScopeUser = "user"
+	loginScopeIP   = "ip"
+)
+
+// loginPolicy describes how many failed sign-in attempts are tolerated before
+// the subject gets locked out, and for how long.
+type loginPolicy struct {
+	// Allowed is the number of failures accepted without any delay.
+	Allowed int64
+
+	// BaseDelay is the lockout applied after the first failure above the
+	// allowed limit. It doubles with every next failure up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// Window is the time after which the failures are forgotten, if there was
+	// no new failed attempt in the meantime.
+	Window time.Duration
+}
+
+func (p loginPolicy) lockout(failures int64) time.Duration {
+	if failures <= p.Allowed {
+		return 0
+	}
+	delay := p.BaseDelay
+	for i := p.Allowed + 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// loginThrottle tracks failed sign-in attempts per username and per remote IP
+// address. The state is kept in the database, so the lockouts survive the
+// restart of the console.
+type loginThrottle struct {
+	DB       *database.SQLite
+	Policies map[string]loginPolicy
+
+	now func() time.Time
+}
+
+func newLoginThrottle(db *database.SQLite, now func() time.Time) *loginThrottle {
+	return &loginThrottle{
+		DB: db,
+		Policies: map[string]loginPolicy{
+			loginScopeUser: {Allowed: 5, BaseDelay: 30 * time.Second, MaxDelay: 15 * time.Minute, Window: time.Hour},
+			loginScopeIP:   {Allowed: 20, BaseDelay: 30 * time.Second, MaxDelay: time.Hour, Window: time.Hour},
+		},
+		now: now,
+	}
+}
+
+// LockedFor returns for how long the sign-in attempts are still blocked for
+// the given username or IP address. Zero means the attempt can proceed.
+func (t *loginThrottle) LockedFor(ctx context.Context, username, ip string) (time.Duration, error) {
+	var wait time.Duration
+	for scope, subject := range loginSubjects(username, ip) {
+		attempt, err := t.DB.Read.GetLoginAttempt(ctx, database.GetLoginAttemptParams{
+			Scope:   scope,
+			Subject: subject,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		wait = max(wait, time.Unix(attempt.LockedUntil, 0).Sub(t.now()))
+	}
+	return wait, nil
+}
+
+// Fail records a failed sign-in attempt. The username is tracked only when it
+// belongs to an existing user, so that guessing random names does not fill up
+// the database.
+func (t *loginThrottle) Fail(ctx context.Context, username, ip string) error {
+	tx, queries, err := t.DB.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := t.now()
+	for scope, subject := range loginSubjects(username, ip) {
+		policy := t.Policies[scope]
+
+		attempt, err := queries.GetLoginAttempt(ctx, database.GetLoginAttemptParams{
+			Scope:   scope,
+			Subject: subject,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return errors.Join(err, tx.Rollback())
+		}
+		if now.Sub(time.Unix(attempt.LastFailure, 0)) > policy.Window {
+			attempt.Failures = 0
+		}
+		attempt.Failures++
+
+		lockedUntil := attempt.LockedUntil
+		if delay := policy.lockout(attempt.Failures); delay > 0 {
+			lockedUntil = now.Add(delay).Unix()
+			metrics.LoginLockouts.WithLabelValues(scope).Inc()
+		}
+
+		if err := queries.UpsertLoginAttempt(ctx, database.UpsertLoginAttemptParams{
+			Scope:       scope,
+			Subject:     subject,
+			Failures:    attempt.Failures,
+			LastFailure: now.Unix(),
+			LockedUntil: lockedUntil,
+		}); err != nil {
+			return errors.Join(err, tx.Rollback())
+		}
+	}
+	return tx.Commit()
+}
+
+// Reset forgets the failed attempts of the username after a successful
+// sign-in. The failures of the IP address are kept until their window
+// expires, otherwise signing in to an own account now and then would let the
+// client try the passwords of the others without ever being locked out.
+func (t *loginThrottle) Reset(ctx context.Context, username string) error {
+	if username == "" {
+		return nil
+	}
+	return t.DB.Write.DeleteLoginAttempt(ctx, database.DeleteLoginAttemptParams{
+		Scope:   loginScopeUser,
+		Subject: strings.ToLower(username),
+	})
+}
+
+func loginSubjects(username, ip string) map[string]string {
+	subjects := make(map[string]string, 2)
+	if username != "" {
+		subjects[loginScopeUser] = strings.ToLower(username)
+	}
+	if ip != "" {
+		subjects[loginScopeIP] = ip
+	}
+	return subjects
+}
+
+// remoteIP returns the host part of the peer address of the request.
+func remoteIP(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+func errTooManyAttempts(wait time.Duration) error {
+	return fmt.Errorf("too many failed sign-in attempts, try again in %s", wait.Round(time.Second))
+}
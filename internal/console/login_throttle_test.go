@@ -0,0 +1,98 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginPolicy_Lockout(t *testing.T) {
+	policy := loginPolicy{Allowed: 3, BaseDelay: 10 * time.Second, MaxDelay: time.Minute}
+
+	assert.Equal(t, time.Duration(0), policy.lockout(1))
+	assert.Equal(t, time.Duration(0), policy.lockout(3))
+	assert.Equal(t, 10*time.Second, policy.lockout(4))
+	assert.Equal(t, 20*time.Second, policy.lockout(5))
+	assert.Equal(t, 40*time.Second, policy.lockout(6))
+	assert.Equal(t, time.Minute, policy.lockout(7))
+	assert.Equal(t, time.Minute, policy.lockout(100))
+}
+
+func TestLoginThrottle(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	newThrottle := func(t *testing.T) *loginThrottle {
+		throttle := newLoginThrottle(setupDatabase(t), func() time.Time { return now })
+		throttle.Policies[loginScopeUser] = loginPolicy{Allowed: 2, BaseDelay: time.Minute, MaxDelay: time.Hour, Window: time.Hour}
+		throttle.Policies[loginScopeIP] = loginPolicy{Allowed: 4, BaseDelay: time.Minute, MaxDelay: time.Hour, Window: time.Hour}
+		return throttle
+	}
+
+	t.Run("locks the username", func(t *testing.T) {
+		throttle := newThrottle(t)
+		for range 3 {
+			assert.NoError(t, throttle.Fail(t.Context(), "Archer", "10.0.0.1"))
+		}
+
+		wait, err := throttle.LockedFor(t.Context(), "archer", "10.0.0.2")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, wait)
+
+		wait, err = throttle.LockedFor(t.Context(), "mage", "10.0.0.1")
+		assert.NoError(t, err)
+		assert.Zero(t, wait, "the address is below its own limit")
+	})
+
+	t.Run("locks the address", func(t *testing.T) {
+		throttle := newThrottle(t)
+		for range 5 {
+			assert.NoError(t, throttle.Fail(t.Context(), "", "10.0.0.1"))
+		}
+
+		wait, err := throttle.LockedFor(t.Context(), "archer", "10.0.0.1")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, wait)
+	})
+
+	t.Run("backoff grows and window expires", func(t *testing.T) {
+		throttle := newThrottle(t)
+		for range 4 {
+			assert.NoError(t, throttle.Fail(t.Context(), "archer", ""))
+		}
+		wait, err := throttle.LockedFor(t.Context(), "archer", "")
+		assert.NoError(t, err)
+		assert.Equal(t, 2*time.Minute, wait)
+
+		throttle.now = func() time.Time { return now.Add(2 * time.Hour) }
+		assert.NoError(t, throttle.Fail(t.Context(), "archer", ""))
+		wait, err = throttle.LockedFor(t.Context(), "archer", "")
+		assert.NoError(t, err)
+		assert.Zero(t, wait, "old failures should be forgotten")
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		throttle := newThrottle(t)
+		for range 3 {
+			assert.NoError(t, throttle.Fail(t.Context(), "archer", "10.0.0.1"))
+		}
+		assert.NoError(t, throttle.Reset(t.Context(), "Archer"))
+
+		wait, err := throttle.LockedFor(t.Context(), "archer", "10.0.0.1")
+		assert.NoError(t, err)
+		assert.Zero(t, wait)
+	})
+
+	t.Run("reset keeps the address failures", func(t *testing.T) {
+		throttle := newThrottle(t)
+		for _, username := range []string{"mage", "knight", "rogue", "druid"} {
+			assert.NoError(t, throttle.Fail(t.Context(), username, "10.0.0.1"))
+		}
+		// The client signs in to its own account in between.
+		assert.NoError(t, throttle.Reset(t.Context(), "archer"))
+		assert.NoError(t, throttle.Fail(t.Context(), "paladin", "10.0.0.1"))
+
+		wait, err := throttle.LockedFor(t.Context(), "archer", "10.0.0.1")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, wait)
+	})
+}
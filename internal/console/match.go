@@ -0,0 +1,129 @@
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/gen/multi/v1/multiv1connect"
+	"github.com/dimspell/gladiator/internal/console/database"
+)
+
+var _ multiv1connect.MatchServiceHandler = (*matchServiceServer)(nil)
+
+// The number of the matches returned by ListMatches, when the limit is not
+// given, and the most it returns at once.
+const (
+	defaultMatchesLimit = 20
+	maxMatchesLimit     = 100
+)
+
+type matchServiceServer struct {
+	DB *database.SQLite
+}
+
+// ListMatches returns the recent matches, starting from the latest one.
+func (s *matchServiceServer) ListMatches(ctx context.Context, req *connect.Request[multiv1.ListMatchesRequest]) (*connect.Response[multiv1.ListMatchesResponse], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	limit := req.Msg.GetLimit()
+	if limit <= 0 {
+		limit = defaultMatchesLimit
+	}
+	limit = min(limit, maxMatchesLimit)
+	offset := max(req.Msg.GetOffset(), 0)
+
+	var (
+		matches []database.Match
+		err     error
+	)
+	if userID := req.Msg.GetPlayerUserId(); userID != 0 {
+		matches, err = s.DB.Read.ListPlayerMatches(ctx, database.ListPlayerMatchesParams{
+			UserID: userID,
+			Limit:  limit,
+			Offset: offset,
+		})
+	} else {
+		matches, err = s.DB.Read.ListMatches(ctx, database.ListMatchesParams{
+			Limit:  limit,
+			Offset: offset,
+		})
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	list := make([]*multiv1.Match, 0, len(matches))
+	for _, m := range matches {
+		match, err := s.toMatch(ctx, m)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		list = append(list, match)
+	}
+
+	resp := connect.NewResponse(&multiv1.ListMatchesResponse{Matches: list})
+	return resp, nil
+}
+
+// GetMatch returns the match with its participants.
+func (s *matchServiceServer) GetMatch(ctx context.Context, req *connect.Request[multiv1.GetMatchRequest]) (*connect.Response[multiv1.GetMatchResponse], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m, err := s.DB.Read.GetMatch(ctx, req.Msg.GetMatchId())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("match %d not found", req.Msg.GetMatchId()))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	match, err := s.toMatch(ctx, m)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := connect.NewResponse(&multiv1.GetMatchResponse{Match: match})
+	return resp, nil
+}
+
+func (s *matchServiceServer) toMatch(ctx context.Context, m database.Match) (*multiv1.Match, error) {
+	players, err := s.DB.Read.ListMatchPlayers(ctx, m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants := make([]*multiv1.MatchParticipant, len(players))
+	for i, player := range players {
+		participants[i] = &multiv1.MatchParticipant{
+			UserId:          player.UserID,
+			Username:        player.Username,
+			CharacterId:     player.CharacterID,
+			ClassType:       multiv1.ClassType(player.ClassType),
+			JoinedAt:        player.JoinedAt,
+			LeftAt:          player.LeftAt,
+			ScoreDelta:      player.ScoreDelta,
+			ExperienceDelta: player.ExperienceDelta,
+			MoneyDelta:      player.MoneyDelta,
+		}
+	}
+
+	return &multiv1.Match{
+		MatchId:         m.ID,
+		GameRoomId:      m.GameRoomID,
+		Name:            m.Name,
+		MapId:           multiv1.GameMap(m.MapID),
+		HostUserId:      m.HostUserID,
+		StartedAt:       m.StartedAt,
+		EndedAt:         m.EndedAt,
+		DurationSeconds: m.EndedAt - m.StartedAt,
+		Participants:    participants,
+	}, nil
+}
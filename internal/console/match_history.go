@@ -0,0 +1,261 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/app/logger/logging"
+	"github.com/dimspell/gladiator/internal/console/database"
+)
+
+// MatchHistory records the matches played in the game rooms: who has taken
+// part in them and how the stats of their characters have changed. The match
+// starts when the room is opened and it is stored once the room is closed.
+type MatchHistory struct {
+	DB *database.SQLite
+
+	mu sync.Mutex
+	// matches are the matches in progress by the ID of their game room.
+	matches map[string]*match
+
+	// saving tracks the finished matches being stored.
+	saving sync.WaitGroup
+
+	now func() time.Time
+}
+
+type match struct {
+	roomID     string
+	name       string
+	mapID      v1.GameMap
+	hostUserID int64
+	startedAt  time.Time
+	players    []*matchPlayer
+}
+
+// matchPlayer is a stay of the player in the match, from joining until
+// leaving the room.
+type matchPlayer struct {
+	userID      int64
+	username    string
+	characterID int64
+	classType   byte
+	joinedAt    time.Time
+	leftAt      time.Time
+
+	score      int64
+	experience int64
+	money      int64
+}
+
+func NewMatchHistory(db *database.SQLite, now func() time.Time) *MatchHistory {
+	return &MatchHistory{DB: db, matches: make(map[string]*match), now: now}
+}
+
+// Observe starts the match when the game room is opened and stores it when
+// the room is closed. It is meant to be registered with OnRoomStateChange.
+// The rooms restored after the restart of the console are not recorded.
+func (h *MatchHistory) Observe(change RoomStateChange) {
+	if h == nil {
+		return
+	}
+
+	switch change.To {
+	case v1.GameState_GameStateOpen:
+		h.start(change)
+	case v1.GameState_GameStateClosing:
+		h.finish(change.Room.ID, change.At)
+	}
+}
+
+func (h *MatchHistory) start(change RoomStateChange) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// The game opened again after being started is the same match.
+	if _, ok := h.matches[change.Room.ID]; ok {
+		return
+	}
+
+	m := &match{
+		roomID:    change.Room.ID,
+		name:      change.Room.Name,
+		mapID:     change.Room.MapID,
+		startedAt: change.At,
+	}
+	if change.Room.HostPlayer != nil {
+		m.hostUserID = change.Room.HostPlayer.UserID
+	}
+	for _, session := range change.Room.Players {
+		m.players = append(m.players, newMatchPlayer(session, change.At))
+	}
+	h.matches[m.roomID] = m
+}
+
+func newMatchPlayer(session *UserSession, joinedAt time.Time) *matchPlayer {
+	return &matchPlayer{
+		userID:      session.UserID,
+		username:    session.User.Username,
+		characterID: session.Character.CharacterID,
+		classType:   session.Character.ClassType,
+		joinedAt:    joinedAt,
+	}
+}
+
+// PlayerJoined adds the player to the match played in the game room.
+func (h *MatchHistory) PlayerJoined(roomID string, session *UserSession) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if m, ok := h.matches[roomID]; ok {
+		m.players = append(m.players, newMatchPlayer(session, h.now()))
+	}
+}
+
+// PlayerLeft marks when the player has left the match played in the game
+// room.
+func (h *MatchHistory) PlayerLeft(roomID string, userID int64) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if m, ok := h.matches[roomID]; ok {
+		if player := m.current(userID); player != nil {
+			player.leftAt = h.now()
+		}
+	}
+}
+
+// current returns the stay of the player, who has not left the match yet.
+func (m *match) current(userID int64) *matchPlayer {
+	for _, player := range m.players {
+		if player.userID == userID && player.leftAt.IsZero() {
+			return player
+		}
+	}
+	return nil
+}
+
+// Playing reports whether the user takes part in any match in progress.
+func (h *MatchHistory) Playing(userID int64) bool {
+	if h == nil {
+		return false
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, m := range h.matches {
+		if m.current(userID) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordStats adds the changes of the character stats to the match the user
+// is playing. The changes of the other characters of the user are ignored.
+func (h *MatchHistory) RecordStats(userID, characterID, score, experience, money int64) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, m := range h.matches {
+		player := m.current(userID)
+		if player == nil {
+			continue
+		}
+		if player.characterID != 0 && player.characterID != characterID {
+			return
+		}
+		player.characterID = characterID
+		player.score += score
+		player.experience += experience
+		player.money += money
+		return
+	}
+}
+
+// finish ends the match played in the game room and stores it in the
+// background, because it is called with the rooms mutex held. The failures
+// are only logged.
+func (h *MatchHistory) finish(roomID string, endedAt time.Time) {
+	h.mu.Lock()
+	m, ok := h.matches[roomID]
+	delete(h.matches, roomID)
+	h.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	for _, player := range m.players {
+		if player.leftAt.IsZero() {
+			player.leftAt = endedAt
+		}
+	}
+
+	h.saving.Add(1)
+	go func() {
+		defer h.saving.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		if err := h.save(ctx, m, endedAt); err != nil {
+			slog.Warn("Could not store the match", "gameId", roomID, logging.Error(err))
+		}
+	}()
+}
+
+// Wait waits until the finished matches are stored.
+func (h *MatchHistory) Wait() {
+	if h == nil {
+		return
+	}
+	h.saving.Wait()
+}
+
+func (h *MatchHistory) save(ctx context.Context, m *match, endedAt time.Time) error {
+	tx, queries, err := h.DB.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored, err := queries.CreateMatch(ctx, database.CreateMatchParams{
+		GameRoomID: m.roomID,
+		Name:       m.name,
+		MapID:      int64(m.mapID),
+		HostUserID: m.hostUserID,
+		StartedAt:  m.startedAt.Unix(),
+		EndedAt:    endedAt.Unix(),
+	})
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	for _, player := range m.players {
+		if err := queries.CreateMatchPlayer(ctx, database.CreateMatchPlayerParams{
+			MatchID:         stored.ID,
+			UserID:          player.userID,
+			Username:        player.username,
+			CharacterID:     player.characterID,
+			ClassType:       int64(player.classType),
+			JoinedAt:        player.joinedAt.Unix(),
+			LeftAt:          player.leftAt.Unix(),
+			ScoreDelta:      player.score,
+			ExperienceDelta: player.experience,
+			MoneyDelta:      player.money,
+		}); err != nil {
+			return errors.Join(err, tx.Rollback())
+		}
+	}
+	return tx.Commit()
+}
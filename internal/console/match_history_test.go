@@ -0,0 +1,171 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/model"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchHistory(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := start
+
+	db := setupDatabase(t)
+	mp := NewMultiplayer()
+	mp.now = func() time.Time { return clock }
+	mp.Matches = NewMatchHistory(db, mp.now)
+	mp.OnRoomStateChange(mp.Matches.Observe)
+
+	for id, username := range map[int64]string{1: "archer", 2: "mage"} {
+		character, err := db.Write.CreateCharacter(t.Context(), database.CreateCharacterParams{
+			UserID:           id,
+			CharacterName:    username,
+			ClassType:        int64(id),
+			ExperiencePoints: 100,
+			Money:            50,
+			ScorePoints:      10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		session := NewUserSession(id, &recordingConn{})
+		session.User = wire.User{UserID: id, Username: username}
+		session.Character = wire.Character{CharacterID: character.ID, ClassType: byte(id)}
+		mp.AddUserSession(id, session)
+	}
+	characters := &characterServiceServer{DB: db, Matches: mp.Matches}
+	putStats := func(userID int64, name string, experience, money, score uint32) {
+		t.Helper()
+		info := model.CharacterInfo{ExperiencePoints: experience, Money: money, ScorePoints: score}
+		_, err := characters.PutStats(t.Context(), connect.NewRequest(&v1.PutStatsRequest{
+			UserId:        userID,
+			CharacterName: name,
+			Stats:         info.ToBytes(),
+		}))
+		assert.NoError(t, err)
+	}
+
+	// The stats reported before the match are not recorded.
+	putStats(1, "archer", 100, 50, 10)
+
+	_, err := mp.CreateRoom(1, "room", "", v1.GameMap_AbandonedRealm, "10.0.0.1", 0)
+	assert.NoError(t, err)
+	openRoom(t, mp, "room")
+
+	clock = start.Add(time.Minute)
+	_, err = mp.JoinRoom("room", 2, "10.0.0.2")
+	assert.NoError(t, err)
+	assert.True(t, mp.Matches.Playing(2))
+
+	clock = start.Add(5 * time.Minute)
+	putStats(1, "archer", 160, 40, 15)
+	putStats(2, "mage", 130, 80, 12)
+	putStats(2, "mage", 150, 80, 13)
+
+	clock = start.Add(10 * time.Minute)
+	mage, _ := mp.GetUserSession(2)
+	mp.LeaveRoom(t.Context(), mage)
+	assert.False(t, mp.Matches.Playing(2))
+	putStats(2, "mage", 500, 500, 50)
+
+	clock = start.Add(30 * time.Minute)
+	archer, _ := mp.GetUserSession(1)
+	mp.LeaveRoom(t.Context(), archer)
+	assert.False(t, mp.Matches.Playing(1))
+	mp.Matches.Wait()
+
+	matches := &matchServiceServer{DB: db}
+	resp, err := matches.ListMatches(t.Context(), connect.NewRequest(&v1.ListMatchesRequest{}))
+	if !assert.NoError(t, err) || !assert.Len(t, resp.Msg.Matches, 1) {
+		return
+	}
+	match := resp.Msg.Matches[0]
+	assert.Equal(t, "room", match.GameRoomId)
+	assert.Equal(t, v1.GameMap_AbandonedRealm, match.MapId)
+	assert.Equal(t, int64(1), match.HostUserId)
+	assert.Equal(t, start.Unix(), match.StartedAt)
+	assert.Equal(t, int64(30*60), match.DurationSeconds)
+
+	if assert.Len(t, match.Participants, 2) {
+		host, guest := match.Participants[0], match.Participants[1]
+		assert.Equal(t, "archer", host.Username)
+		assert.Equal(t, start.Unix(), host.JoinedAt)
+		assert.Equal(t, start.Add(30*time.Minute).Unix(), host.LeftAt)
+		assert.Equal(t, int64(60), host.ExperienceDelta)
+		assert.Equal(t, int64(-10), host.MoneyDelta)
+		assert.Equal(t, int64(5), host.ScoreDelta)
+
+		assert.Equal(t, "mage", guest.Username)
+		assert.Equal(t, v1.ClassType(2), guest.ClassType)
+		assert.Equal(t, start.Add(time.Minute).Unix(), guest.JoinedAt)
+		assert.Equal(t, start.Add(10*time.Minute).Unix(), guest.LeftAt)
+		assert.Equal(t, int64(50), guest.ExperienceDelta)
+		assert.Equal(t, int64(30), guest.MoneyDelta)
+		assert.Equal(t, int64(3), guest.ScoreDelta)
+	}
+
+	got, err := matches.GetMatch(t.Context(), connect.NewRequest(&v1.GetMatchRequest{MatchId: match.MatchId}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, match.Participants[1].MoneyDelta, got.Msg.Match.Participants[1].MoneyDelta)
+	}
+}
+
+func TestMatchServiceServer(t *testing.T) {
+	db := setupDatabase(t)
+	for i, players := range [][]int64{{1, 2}, {2}, {1, 3}} {
+		match, err := db.Write.CreateMatch(t.Context(), database.CreateMatchParams{
+			GameRoomID: "room",
+			Name:       "room",
+			StartedAt:  int64(i * 100),
+			EndedAt:    int64(i*100 + 50),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, userID := range players {
+			if err := db.Write.CreateMatchPlayer(t.Context(), database.CreateMatchPlayerParams{
+				MatchID: match.ID,
+				UserID:  userID,
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	s := &matchServiceServer{DB: db}
+
+	ids := func(matches []*v1.Match) []int64 {
+		var list []int64
+		for _, m := range matches {
+			list = append(list, m.MatchId)
+		}
+		return list
+	}
+
+	t.Run("recent first", func(t *testing.T) {
+		resp, err := s.ListMatches(t.Context(), connect.NewRequest(&v1.ListMatchesRequest{}))
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3, 2, 1}, ids(resp.Msg.Matches))
+
+		resp, err = s.ListMatches(t.Context(), connect.NewRequest(&v1.ListMatchesRequest{Limit: 1, Offset: 1}))
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2}, ids(resp.Msg.Matches))
+	})
+
+	t.Run("player history", func(t *testing.T) {
+		resp, err := s.ListMatches(t.Context(), connect.NewRequest(&v1.ListMatchesRequest{PlayerUserId: 1}))
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3, 1}, ids(resp.Msg.Matches))
+		assert.Len(t, resp.Msg.Matches[0].Participants, 2)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := s.GetMatch(t.Context(), connect.NewRequest(&v1.GetMatchRequest{MatchId: 404}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
@@ -1,23 +1,34 @@
 package console
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/coder/websocket"
 	v1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
+	"github.com/dimspell/gladiator/internal/console/auth"
+	"github.com/dimspell/gladiator/internal/model"
 	"github.com/dimspell/gladiator/internal/wire"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // Multiplayer is a control plane for the lobby, presence and the matchmaking.
 type Multiplayer struct {
-	done context.CancelFunc
+	// stopped is cancelled by Stop. It is created with the Multiplayer, so
+	// Stop can be called before or concurrently with Run.
+	stopped context.Context
+	done    context.CancelFunc
 
 	// Presence in a lobby
 	sessionMutex sync.RWMutex
@@ -25,10 +36,59 @@ type Multiplayer struct {
 
 	Messages chan wire.Message
 
+	// Channels of the lobby, each with its own list of players and chat.
+	Channels *ChannelList
+
+	// Moderation limits and filters the chat messages. They are not moderated
+	// when nil.
+	Moderation *ChatModeration
+
+	// Friends are notified when the user connects, disconnects, creates or
+	// joins a game room. Nobody is notified when nil.
+	Friends *FriendList
+
+	// Commands handles the chat messages starting with a slash.
+	Commands *CommandRouter
+
+	// History stores the chat messages of the channels. It is not recorded
+	// when nil.
+	History *ChatHistory
+
+	// MOTD is the message of the day of the channels, which have no message
+	// on their own.
+	MOTD string
+
 	// Game rooms
 	roomsMutex sync.RWMutex
 	Rooms      map[string]*GameRoom
 
+	// RoomStore keeps the game rooms in the database, so they can be restored
+	// after the restart. The rooms are kept only in memory when nil.
+	RoomStore *RoomStore
+
+	// stopping is set when the console shuts down, so the rooms left by the
+	// disconnected players are not removed from the database.
+	stopping atomic.Bool
+
+	// Reaper closes the game rooms, which have been abandoned.
+	Reaper RoomReaperPolicy
+
+	// KickBan is how long the player kicked by the host cannot join the game
+	// room again.
+	KickBan time.Duration
+
+	// Matches records the games played in the rooms. They are not recorded
+	// when nil.
+	Matches *MatchHistory
+
+	// Listeners of the game room state transitions.
+	listenersMutex sync.Mutex
+	roomListeners  []func(RoomStateChange)
+
+	// now is the clock of the console. NewConsole passes it to the other
+	// components, so the time is read from a single source.
+	now func() time.Time
+
 	Relay *Relay
 }
 
@@ -37,13 +97,25 @@ func NewMultiplayer() *Multiplayer {
 		sessions: make(map[int64]*UserSession),
 		Rooms:    make(map[string]*GameRoom),
 		Messages: make(chan wire.Message),
+		Commands: NewCommandRouter(),
+		now:      time.Now,
+	}
+	mp.stopped, mp.done = context.WithCancel(context.Background())
+	for _, cmd := range defaultCommands() {
+		if err := mp.Commands.Register(cmd); err != nil {
+			panic(err)
+		}
 	}
 	return mp
 }
 
-func (mp *Multiplayer) Stop() { mp.done() }
+func (mp *Multiplayer) Stop() {
+	mp.stopping.Store(true)
+	mp.done()
+}
 
 func (mp *Multiplayer) Reset() {
+	mp.stopping.Store(true)
 	mp.forEachSession(func(userSession *UserSession) bool {
 		_ = userSession.wsConn.CloseNow()
 		return true
@@ -51,12 +123,26 @@ func (mp *Multiplayer) Reset() {
 	clear(mp.sessions)
 	close(mp.Messages)
 	clear(mp.Rooms)
+	mp.Matches.Wait()
 }
 
 func (mp *Multiplayer) Run(ctx context.Context) {
 	ctx, done := context.WithCancel(ctx)
-	mp.done = done
 	defer done()
+	defer context.AfterFunc(mp.stopped, done)()
+
+	var reap <-chan time.Time
+	if mp.Reaper.Interval > 0 {
+		ticker := time.NewTicker(mp.Reaper.Interval)
+		defer ticker.Stop()
+		reap = ticker.C
+	}
+	var purge <-chan time.Time
+	if mp.History != nil && mp.History.TTL > 0 {
+		ticker := time.NewTicker(chatPurgeInterval)
+		defer ticker.Stop()
+		purge = ticker.C
+	}
 
 	for {
 		select {
@@ -65,6 +151,14 @@ func (mp *Multiplayer) Run(ctx context.Context) {
 			mp.Reset()
 			return
 
+		case <-reap:
+			mp.ReapRooms(ctx)
+
+		case <-purge:
+			if err := mp.History.Purge(ctx); err != nil {
+				slog.Warn("Could not delete the expired chat messages", logging.Error(err))
+			}
+
 		case msg, ok := <-mp.Messages:
 			if !ok {
 				return
@@ -80,15 +174,8 @@ func (mp *Multiplayer) HandleIncomingMessage(ctx context.Context, msg wire.Messa
 	slog.Debug("Received a signal message", "type", msg.Type.String(), "from", msg.From, "to", msg.To)
 
 	switch msg.Type {
-	case wire.Chat:
-		mp.BroadcastMessage(ctx, wire.Compose(wire.Chat, wire.Message{
-			From:    msg.From,
-			Content: msg.Content,
-		}))
 	case wire.RTCOffer, wire.RTCAnswer, wire.RTCICECandidate:
 		mp.ForwardRTCMessage(ctx, msg)
-	case wire.SetRoomReady:
-		mp.SetRoomReady(msg)
 	default:
 		// Do nothing but log the event type
 		slog.Error("Unhandled event type", "type", msg.Type.String())
@@ -108,6 +195,7 @@ func (mp *Multiplayer) HandleSession(ctx context.Context, session *UserSession)
 
 	// Add user to the list of connected players.
 	mp.SetPlayerConnected(session)
+	mp.ReplayChatHistory(ctx, session, session.Channel)
 
 	// Remove the player
 	defer mp.SetPlayerDisconnected(session)
@@ -136,6 +224,63 @@ func (mp *Multiplayer) HandleSession(ctx context.Context, session *UserSession)
 			}
 		}
 
+		// Switching the channel, the chat, the private messages and opening
+		// the game room are done on behalf of the user of the session, there
+		// is no need to pass them through the message pump.
+		switch wire.ParseEventType(payload) {
+		case wire.JoinChannel:
+			_, m, err := wire.DecodeTyped[wire.ChannelJoin](payload)
+			if err != nil {
+				slog.Error("Could not decode the message", logging.Error(err), "payload", string(payload))
+				return err
+			}
+			if err := mp.JoinChannel(ctx, session, m.Content); err != nil {
+				slog.Info("Could not join the channel", logging.Error(err), "userId", session.UserID, "channel", m.Content.Channel)
+			}
+			continue
+		case wire.Chat:
+			_, m, err := wire.DecodeTyped[wire.ChatMessage](payload)
+			if err != nil {
+				slog.Error("Could not decode the message", logging.Error(err), "payload", string(payload))
+				return err
+			}
+			if !mp.allowChat(ctx, session) {
+				continue
+			}
+			if mp.Commands.Handle(ctx, mp, session, m.Content.Text) {
+				continue
+			}
+			text, err := mp.moderateChat(ctx, session, m.Content.Text)
+			if err != nil {
+				continue
+			}
+			mp.PostChatMessage(ctx, session, text)
+			continue
+		case wire.PrivateMessage:
+			_, m, err := wire.DecodeTyped[wire.Whisper](payload)
+			if err != nil {
+				slog.Error("Could not decode the message", logging.Error(err), "payload", string(payload))
+				return err
+			}
+			if !mp.allowChat(ctx, session) {
+				continue
+			}
+			if err := mp.SendPrivateMessage(ctx, session, m.Content); err != nil {
+				slog.Debug("Could not deliver the private message", logging.Error(err), "userId", session.UserID)
+			}
+			continue
+		case wire.SetRoomReady:
+			_, m, err := wire.DecodeTyped[string](payload)
+			if err != nil {
+				slog.Error("Could not decode the message", logging.Error(err), "payload", string(payload))
+				return err
+			}
+			if err := mp.SetRoomReady(session, m.Content); err != nil {
+				slog.Warn("Could not open the game room", logging.Error(err), "userId", session.UserID, "gameId", m.Content)
+			}
+			continue
+		}
+
 		// Enqueue message
 		_, m, err := wire.Decode(payload)
 		if err != nil {
@@ -171,24 +316,70 @@ func (mp *Multiplayer) DebugState() {
 }
 
 type GameRoom struct {
-	Ready    bool
-	ID       string
-	Name     string
-	Password string // TODO: Yup, game expects the password in plain-text
-	MapID    v1.GameMap
+	ID    string
+	Name  string
+	MapID v1.GameMap
+
+	// State is the stage of the room lifecycle, changed with transitionRoom.
+	State v1.GameState
+
+	// PasswordHash is the bcrypt hash of the room password. The room is open
+	// to everyone, when it is empty.
+	PasswordHash string
+
+	// MaxPlayers is the maximum number of the players in the room, the host
+	// included.
+	MaxPlayers int
 
 	HostPlayer *UserSession
 	CreatedBy  *UserSession
 
 	Players map[int64]*UserSession
+
+	// CreatedAt is when the room has been created, ActiveAt when a player
+	// has joined or left the room for the last time.
+	CreatedAt time.Time
+	ActiveAt  time.Time
+
+	// hostLostAt is when the host has been found disconnected by the reaper.
+	hostLostAt time.Time
+
+	// restored is set until the players of the room restored from the
+	// database are reconciled.
+	restored bool
+
+	// kickedUntil tells until when the players kicked by the host cannot
+	// join the room again.
+	kickedUntil map[int64]time.Time
 }
 
-// ListRooms returns list of all created game rooms.
-func (mp *Multiplayer) ListRooms() map[string]*GameRoom {
+// ListRooms returns list of all created game rooms. The rooms are copied,
+// while the lock is held, so they can be read after it is released.
+func (mp *Multiplayer) ListRooms() []GameRoom {
 	mp.roomsMutex.RLock()
 	defer mp.roomsMutex.RUnlock()
 
-	return mp.Rooms
+	rooms := make([]GameRoom, 0, len(mp.Rooms))
+	for _, room := range mp.Rooms {
+		rooms = append(rooms, room.snapshot())
+	}
+	return rooms
+}
+
+// snapshot returns a copy of the room with its own list of the players.
+func (room *GameRoom) snapshot() GameRoom {
+	copied := *room
+	copied.Players = maps.Clone(room.Players)
+	return copied
+}
+
+// HostAddress returns the ID and the IP address of the host. They are empty,
+// when the room has no host.
+func (room *GameRoom) HostAddress() (int64, string) {
+	if room.HostPlayer == nil {
+		return 0, ""
+	}
+	return room.HostPlayer.UserID, room.HostPlayer.IPAddress
 }
 
 func (mp *Multiplayer) GetRoom(roomId string) (GameRoom, bool) {
@@ -199,11 +390,47 @@ func (mp *Multiplayer) GetRoom(roomId string) (GameRoom, bool) {
 	if !found {
 		return GameRoom{}, false
 	}
-	return *room, found
+	return room.snapshot(), found
 }
 
-// CreateRoom creates new game room.
-func (mp *Multiplayer) CreateRoom(hostUserID int64, gameID string, password string, mapID v1.GameMap, hostIpAddress string) (*GameRoom, error) {
+// HasPassword reports whether the room has been created with a password.
+func (room *GameRoom) HasPassword() bool {
+	return room.PasswordHash != ""
+}
+
+// CheckPassword reports whether the password allows to join the room.
+func (room *GameRoom) CheckPassword(password string) bool {
+	if !room.HasPassword() {
+		return true
+	}
+	return auth.CheckPassword(password, room.PasswordHash)
+}
+
+// IsFull reports whether the room has no place for another player.
+func (room *GameRoom) IsFull() bool {
+	return len(room.Players) >= room.MaxPlayers
+}
+
+// CreateRoom creates new game room. The room is limited to the maximum number
+// of the players supported by the game, when maxPlayers is zero. The password
+// is stored as a hash.
+func (mp *Multiplayer) CreateRoom(hostUserID int64, gameID string, password string, mapID v1.GameMap, hostIpAddress string, maxPlayers int) (*GameRoom, error) {
+	if maxPlayers == 0 {
+		maxPlayers = model.MaxGamePlayers
+	}
+	if maxPlayers < 1 || maxPlayers > model.MaxGamePlayers {
+		return nil, fmt.Errorf("%w: must be between 1 and %d", ErrInvalidMaxPlayers, model.MaxGamePlayers)
+	}
+
+	var passwordHash string
+	if password != "" {
+		hash, err := auth.NewPasswordWithCost(password, roomPasswordCost)
+		if err != nil {
+			return nil, err
+		}
+		passwordHash = hash.String()
+	}
+
 	mp.roomsMutex.Lock()
 	defer mp.roomsMutex.Unlock()
 
@@ -224,26 +451,101 @@ func (mp *Multiplayer) CreateRoom(hostUserID int64, gameID string, password stri
 	hostSession.GameID = gameID
 	hostSession.IPAddress = hostIpAddress
 
+	now := mp.now()
 	room := &GameRoom{
-		Ready:      false,
-		ID:         gameID,
-		Name:       gameID,
-		Password:   password,
-		MapID:      mapID,
-		HostPlayer: hostSession,
-		CreatedBy:  hostSession,
-		Players:    map[int64]*UserSession{hostSession.UserID: hostSession},
+		ID:           gameID,
+		Name:         gameID,
+		MapID:        mapID,
+		PasswordHash: passwordHash,
+		MaxPlayers:   maxPlayers,
+		HostPlayer:   hostSession,
+		CreatedBy:    hostSession,
+		Players:      map[int64]*UserSession{hostSession.UserID: hostSession},
+		CreatedAt:    now,
+		ActiveAt:     now,
 	}
 	mp.Rooms[gameID] = room
+	mp.persistRoom(room)
 	return room, nil
 }
 
-// DestroyRoom deletes an existing game room.
+var (
+	ErrRoomNotFound    = errors.New("room not found")
+	ErrNotRoomMember   = errors.New("user is not a member of the room")
+	ErrSessionNotFound = errors.New("user session not found")
+
+	ErrRoomFull          = errors.New("room is full")
+	ErrInvalidMaxPlayers = errors.New("invalid maximum number of players")
+)
+
+// roomPasswordCost is the bcrypt cost of the room passwords. The rooms live
+// shorter than the accounts, so the passwords are hashed faster.
+const roomPasswordCost = bcrypt.DefaultCost
+
+// AuthorizeRoomMember checks whether the user is the host of the game room or
+// one of the players who have joined it using JoinRoom.
+func (mp *Multiplayer) AuthorizeRoomMember(roomId string, userId int64) error {
+	mp.roomsMutex.RLock()
+	defer mp.roomsMutex.RUnlock()
+
+	room, found := mp.Rooms[roomId]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrRoomNotFound, roomId)
+	}
+	if room.HostPlayer != nil && room.HostPlayer.UserID == userId {
+		return nil
+	}
+	if _, ok := room.Players[userId]; ok {
+		return nil
+	}
+	return fmt.Errorf("%w: user %d, room %s", ErrNotRoomMember, userId, roomId)
+}
+
+// DestroyRoom closes and deletes an existing game room.
 func (mp *Multiplayer) DestroyRoom(roomId string) {
+	room, found := mp.Rooms[roomId]
+	if found {
+		if err := mp.transitionRoom(room, v1.GameState_GameStateClosing); err != nil {
+			slog.Warn("Could not close the game room", logging.Error(err))
+		}
+	}
+
 	delete(mp.Rooms, roomId)
+	mp.forgetRoom(roomId)
+
+	if found {
+		if err := mp.transitionRoom(room, v1.GameState_GameStateClosed); err != nil {
+			slog.Warn("Could not close the game room", logging.Error(err))
+		}
+	}
+}
+
+// CloseRoom destroys the game room on demand of an admin. The players are
+// detached from the room and disconnected from the relay server.
+func (mp *Multiplayer) CloseRoom(roomId string) error {
+	mp.roomsMutex.Lock()
+	room, found := mp.Rooms[roomId]
+	if !found {
+		mp.roomsMutex.Unlock()
+		return fmt.Errorf("%w: %s", ErrRoomNotFound, roomId)
+	}
+	for _, player := range room.Players {
+		player.GameID = ""
+	}
+	mp.DestroyRoom(roomId)
+	mp.roomsMutex.Unlock()
+
+	if mp.Relay != nil {
+		mp.Relay.Server.closeRoom(roomId)
+	}
+	return nil
 }
 
 // JoinRoom adds a player to an existing game room.
+//
+// TODO: Verify the password of the protected rooms. It is not known yet where
+// the game sends the password typed by the player, so the password is not
+// checked at all, instead of locking every protected room.
 func (mp *Multiplayer) JoinRoom(roomId string, userId int64, ipAddr string) (GameRoom, error) {
 	mp.roomsMutex.Lock()
 	defer mp.roomsMutex.Unlock()
@@ -269,6 +571,19 @@ func (mp *Multiplayer) JoinRoom(roomId string, userId int64, ipAddr string) (Gam
 		return GameRoom{}, fmt.Errorf("user session %d already joined", userId)
 	}
 
+	if until, ok := room.kickedUntil[userId]; ok {
+		if mp.now().Before(until) {
+			return GameRoom{}, fmt.Errorf("%w: user %d, room %s", ErrKickedFromRoom, userId, roomId)
+		}
+		delete(room.kickedUntil, userId)
+	}
+	if !room.Joinable() {
+		return GameRoom{}, fmt.Errorf("%w: %s is %s", ErrRoomNotJoinable, roomId, room.State)
+	}
+	if room.IsFull() {
+		return GameRoom{}, fmt.Errorf("%w: %s has %d of %d players", ErrRoomFull, roomId, len(room.Players), room.MaxPlayers)
+	}
+
 	// Override the IP address
 	joiningPlayer.IPAddress = ipAddr
 	joiningPlayer.GameID = room.ID
@@ -276,8 +591,11 @@ func (mp *Multiplayer) JoinRoom(roomId string, userId int64, ipAddr string) (Gam
 
 	// Update the game room
 	room.Players[userId] = joiningPlayer
+	room.ActiveAt = mp.now()
+	mp.persistRoom(room)
+	mp.Matches.PlayerJoined(room.ID, joiningPlayer)
 
-	return *room, nil
+	return room.snapshot(), nil
 }
 
 // LeaveRoom removes a player from a game room.
@@ -291,9 +609,11 @@ func (mp *Multiplayer) LeaveRoom(ctx context.Context, session *UserSession) {
 	}
 
 	// Was the player the game host?
-	playerWasHost := room.HostPlayer.UserID == session.UserID
+	playerWasHost := room.HostPlayer == nil || room.HostPlayer.UserID == session.UserID
 
 	delete(room.Players, session.UserID)
+	room.ActiveAt = mp.now()
+	mp.Matches.PlayerLeft(room.ID, session.UserID)
 
 	if len(room.Players) == 0 {
 		// There is nobody in the room, so we can destroy it
@@ -305,20 +625,10 @@ func (mp *Multiplayer) LeaveRoom(ctx context.Context, session *UserSession) {
 		// Find the user who will become the new host
 		room.HostPlayer = mp.GetNextHost(room)
 	}
+	mp.persistRoom(room)
 
 	for id, player := range room.Players {
-		player.Send(ctx, wire.Compose(wire.LeaveRoom, wire.Message{
-			To:   strconv.Itoa(int(id)),
-			From: strconv.Itoa(int(session.UserID)),
-			Type: wire.LeaveRoom,
-			Content: wire.Player{
-				UserID:      session.UserID,
-				Username:    session.User.Username,
-				CharacterID: session.Character.CharacterID,
-				ClassType:   session.Character.ClassType,
-				IPAddress:   session.IPAddress,
-			},
-		}))
+		player.Send(ctx, composeLeaveRoom(id, session))
 
 		if playerWasHost && room.HostPlayer != nil {
 			player.Send(ctx, wire.Compose(wire.HostMigration, wire.Message{
@@ -339,20 +649,47 @@ func (mp *Multiplayer) LeaveRoom(ctx context.Context, session *UserSession) {
 	// mp.Relay.Server.switchHost(roomID, peerID)
 }
 
+// composeLeaveRoom composes the message telling the player that the other one
+// has left the game room.
+func composeLeaveRoom(to int64, leaving *UserSession) []byte {
+	return wire.Compose(wire.LeaveRoom, wire.Message{
+		To:   strconv.Itoa(int(to)),
+		From: strconv.Itoa(int(leaving.UserID)),
+		Type: wire.LeaveRoom,
+		Content: wire.Player{
+			UserID:      leaving.UserID,
+			Username:    leaving.User.Username,
+			CharacterID: leaving.Character.CharacterID,
+			ClassType:   leaving.Character.ClassType,
+			IPAddress:   leaving.IPAddress,
+		},
+	})
+}
+
 // GetNextHost returns the next host of the game room.
 func (mp *Multiplayer) GetNextHost(room *GameRoom) *UserSession {
-	var earliest *UserSession
+	return room.earliestPlayer()
+}
 
-	// Find the player who joined the room earliest
+// earliestPlayer returns the player who joined the room first.
+func (room *GameRoom) earliestPlayer() *UserSession {
+	var earliest *UserSession
 	for _, player := range room.Players {
 		if earliest == nil || player.JoinedAt.Before(earliest.JoinedAt) {
 			earliest = player
 		}
 	}
-
 	return earliest
 }
 
+// AnnounceRoomCreated notifies the friends of the host about the new room.
+func (mp *Multiplayer) AnnounceRoomCreated(room *GameRoom) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	mp.NotifyFriends(ctx, room.HostPlayer.UserID, fmt.Sprintf("Your friend %s created the game room %s", room.HostPlayer.User.Username, room.Name))
+}
+
 func (mp *Multiplayer) AnnounceJoin(room GameRoom, userId int64) {
 	mp.sessionMutex.Lock()
 
@@ -384,24 +721,30 @@ func (mp *Multiplayer) AnnounceJoin(room GameRoom, userId int64) {
 			},
 		}))
 	}
+
+	mp.NotifyFriends(ctx, userId, fmt.Sprintf("Your friend %s joined the game room %s", joinedPlayer.User.Username, room.Name))
 }
 
 // SetRoomReady notifies the LobbyRoom that it can start accepting players.
-func (mp *Multiplayer) SetRoomReady(msg wire.Message) {
+// Only the host of the room can open it.
+func (mp *Multiplayer) SetRoomReady(session *UserSession, roomId string) error {
 	mp.roomsMutex.Lock()
 	defer mp.roomsMutex.Unlock()
 
-	roomId, ok := msg.Content.(string)
-	if !ok {
-		return
-	}
-
 	lobbyRoom, ok := mp.Rooms[roomId]
 	if !ok {
-		return
+		return fmt.Errorf("%w: %s", ErrRoomNotFound, roomId)
+	}
+	if lobbyRoom.HostPlayer != session {
+		return fmt.Errorf("%w: user %d, room %s", ErrNotRoomHost, session.UserID, roomId)
 	}
 
-	lobbyRoom.Ready = true
+	if err := mp.transitionRoom(lobbyRoom, v1.GameState_GameStateOpen); err != nil {
+		return err
+	}
+	lobbyRoom.ActiveAt = mp.now()
+	mp.persistRoom(lobbyRoom)
+	return nil
 }
 
 func (mp *Multiplayer) HandleHello(ctx context.Context, session *UserSession) error {
@@ -420,7 +763,12 @@ func (mp *Multiplayer) HandleHello(ctx context.Context, session *UserSession) er
 		return fmt.Errorf("inapprioprate event type")
 	}
 
-	session.User = m.Content
+	// The user is resolved by the console from the session token, only the
+	// version is taken from the client.
+	if m.Content.UserID != session.UserID {
+		return fmt.Errorf("%w: hello from user %d", errUserIDMismatch, m.Content.UserID)
+	}
+	session.User.Version = m.Content.Version
 
 	session.Send(ctx, []byte{byte(wire.Welcome)})
 	return nil
@@ -446,29 +794,217 @@ func (mp *Multiplayer) HandleJoinLobby(ctx context.Context, session *UserSession
 	return nil
 }
 
-// SetPlayerConnected notifies the user has connected to the lobby.
+// SetPlayerConnected notifies the user has connected to the lobby and entered
+// the channel of the session.
 func (mp *Multiplayer) SetPlayerConnected(session *UserSession) {
-	players := mp.listSessions()
+	players := mp.listChannelSessions(session.Channel)
 	mp.AddUserSession(session.UserID, session)
+	mp.adoptRoomMembership(session)
 
 	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*3)
 	defer cancel()
 
 	// Include in response also the player who has just joined
 	players = append(players, session.ToPlayer())
+	mp.sendChannelRoster(ctx, session, session.Channel, players)
 
-	session.SendMessage(ctx, wire.LobbyUsers, wire.Message{
-		Type:    wire.LobbyUsers,
-		To:      strconv.FormatInt(session.UserID, 10),
-		Content: players,
-	})
-
-	// Notify all the users
-	mp.BroadcastMessage(ctx, wire.ComposeTyped(wire.JoinLobby, wire.MessageContent[wire.Player]{
+	// Notify all the users in the channel
+	mp.BroadcastChannelMessage(ctx, session.Channel, wire.ComposeTyped(wire.JoinLobby, wire.MessageContent[wire.Player]{
 		From:    strconv.Itoa(int(session.UserID)),
 		Type:    wire.JoinLobby,
 		Content: session.ToPlayer(),
 	}))
+	mp.NotifyFriends(ctx, session.UserID, fmt.Sprintf("Your friend %s is online", session.User.Username))
+}
+
+// JoinChannel moves the user to another channel. The members of the previous
+// channel are notified that the user has left, the members of the new one that
+// the user has joined, and the user receives the list of the players present
+// in the channel. The user stays in the current channel when it is not
+// allowed to enter the new one.
+func (mp *Multiplayer) JoinChannel(ctx context.Context, session *UserSession, req wire.ChannelJoin) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	channel, err := mp.Channels.Get(ctx, req.Channel)
+	if err != nil {
+		mp.rejectChannel(ctx, session, req.Channel, err)
+		return err
+	}
+	level := mp.Channels.CharacterLevel(ctx, session.UserID, session.Character.CharacterID)
+
+	mp.sessionMutex.Lock()
+	previous := session.Channel
+	if previous != channel.Name {
+		members := 0
+		for _, member := range mp.sessions {
+			if member.Channel == channel.Name {
+				members++
+			}
+		}
+		if err := mp.Channels.Admit(channel, session.User.Role, members, level, req.Password); err != nil {
+			mp.sessionMutex.Unlock()
+			mp.rejectChannel(ctx, session, channel.Name, err)
+			return err
+		}
+		session.Channel = channel.Name
+	}
+	mp.sessionMutex.Unlock()
+
+	player := session.ToPlayer()
+	if previous != channel.Name {
+		mp.BroadcastChannelMessage(ctx, previous, wire.Compose(wire.LeaveLobby, wire.Message{
+			Type:    wire.LeaveLobby,
+			From:    strconv.FormatInt(session.UserID, 10),
+			Content: player,
+		}))
+	}
+
+	mp.sendChannelRoster(ctx, session, channel.Name, mp.listChannelSessions(channel.Name))
+
+	if previous != channel.Name {
+		mp.ReplayChatHistory(ctx, session, channel.Name)
+		mp.BroadcastChannelMessage(ctx, channel.Name, wire.ComposeTyped(wire.JoinLobby, wire.MessageContent[wire.Player]{
+			From:    strconv.FormatInt(session.UserID, 10),
+			Type:    wire.JoinLobby,
+			Content: player,
+		}))
+	}
+	return nil
+}
+
+func (mp *Multiplayer) sendChannelRoster(ctx context.Context, session *UserSession, channel string, players []wire.Player) {
+	session.Send(ctx, wire.ComposeTyped(wire.JoinedChannel, wire.MessageContent[wire.ChannelRoster]{
+		Type:    wire.JoinedChannel,
+		To:      strconv.FormatInt(session.UserID, 10),
+		Content: wire.ChannelRoster{Channel: channel, Players: players, MOTD: mp.ChannelMOTD(ctx, channel)},
+	}))
+}
+
+// ChannelMOTD returns the message of the day of the channel, or the global one
+// when the channel has none.
+func (mp *Multiplayer) ChannelMOTD(ctx context.Context, channel string) string {
+	if mp.Channels == nil {
+		return mp.MOTD
+	}
+	ch, err := mp.Channels.Get(ctx, channel)
+	if err != nil || ch.Motd == "" {
+		return mp.MOTD
+	}
+	return ch.Motd
+}
+
+func (mp *Multiplayer) rejectChannel(ctx context.Context, session *UserSession, channel string, reason error) {
+	session.Send(ctx, wire.ComposeTyped(wire.JoinChannelRejected, wire.MessageContent[wire.ChannelRejection]{
+		Type:    wire.JoinChannelRejected,
+		To:      strconv.FormatInt(session.UserID, 10),
+		Content: wire.ChannelRejection{Channel: channel, Reason: reason.Error()},
+	}))
+}
+
+// allowChat reports whether the user can send another message or is flooding
+// the chat. The user is told once that the messages are being dropped.
+func (mp *Multiplayer) allowChat(ctx context.Context, session *UserSession) bool {
+	allowed, started := mp.Moderation.Allow(session.UserID)
+	if started {
+		mp.Moderation.Audit(ctx, ModerationFlood, session.UserID, session.Channel, "flood", "")
+		mp.SendSystemMessage(ctx, session, "Your messages are dropped: "+ErrChatFlood.Error())
+	}
+	return allowed
+}
+
+// moderateChat checks whether the user is muted and runs the word filter over
+// the text. The user is told why the message has been dropped.
+func (mp *Multiplayer) moderateChat(ctx context.Context, session *UserSession, text string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	if err := mp.Moderation.CheckMute(ctx, session.UserID); err != nil {
+		var muteErr *MuteError
+		if !errors.As(err, &muteErr) {
+			// Better to let the message through than to silence everyone.
+			slog.Warn("Could not check the mute", logging.Error(err), "userId", session.UserID)
+			return text, nil
+		}
+		mp.SendSystemMessage(ctx, session, "Your message was dropped, "+err.Error())
+		return "", err
+	}
+
+	text, err := mp.Moderation.Apply(ctx, session.UserID, session.Channel, text)
+	if err != nil {
+		mp.SendSystemMessage(ctx, session, "Your message was dropped: "+err.Error())
+		return "", err
+	}
+	return text, nil
+}
+
+// NotifyFriends sends the system message to the friends of the user, who are
+// connected to the lobby.
+func (mp *Multiplayer) NotifyFriends(ctx context.Context, userID int64, text string) {
+	friends, err := mp.Friends.FriendIDs(ctx, userID)
+	if err != nil {
+		slog.Warn("Could not list the friends", logging.Error(err), "userId", userID)
+		return
+	}
+	for _, friendID := range friends {
+		if session, found := mp.GetUserSession(friendID); found {
+			mp.SendSystemMessage(ctx, session, text)
+		}
+	}
+}
+
+// SendSystemMessage sends a message from the server to the user only.
+func (mp *Multiplayer) SendSystemMessage(ctx context.Context, session *UserSession, text string) {
+	session.Send(ctx, wire.ComposeTyped(wire.SystemMessage, wire.MessageContent[wire.ChatMessage]{
+		Type:    wire.SystemMessage,
+		To:      strconv.FormatInt(session.UserID, 10),
+		Content: wire.ChatMessage{User: "System", Text: text},
+	}))
+}
+
+// recordChatMessage stores the message in the history of the channel of the
+// session. The name of the sender is taken from the session.
+// PostChatMessage sends the message of the user to everyone in the user's
+// channel and records it in the chat history. The sender is always the user
+// of the session, never the one given by the client.
+func (mp *Multiplayer) PostChatMessage(ctx context.Context, session *UserSession, text string) {
+	msg := wire.ChatMessage{User: session.User.Username, Text: text}
+	mp.recordChatMessage(ctx, session, msg)
+	mp.BroadcastChannelMessage(ctx, session.Channel, wire.ComposeTyped(wire.Chat, wire.MessageContent[wire.ChatMessage]{
+		From:    strconv.FormatInt(session.UserID, 10),
+		Content: msg,
+	}))
+}
+
+func (mp *Multiplayer) recordChatMessage(ctx context.Context, session *UserSession, msg wire.ChatMessage) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	msg.User = session.User.Username
+	if err := mp.History.Record(ctx, session.Channel, session.UserID, msg); err != nil {
+		slog.Warn("Could not record the chat message", logging.Error(err), "userId", session.UserID, "channel", session.Channel)
+	}
+}
+
+// ReplayChatHistory sends the recent messages of the channel to the user, in
+// the same shape as the live chat messages.
+func (mp *Multiplayer) ReplayChatHistory(ctx context.Context, session *UserSession, channel string) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	messages, err := mp.History.Recent(ctx, channel)
+	if err != nil {
+		slog.Warn("Could not read the chat history", logging.Error(err), "channel", channel)
+		return
+	}
+	for _, msg := range messages {
+		session.Send(ctx, wire.ComposeTyped(wire.Chat, wire.MessageContent[wire.ChatMessage]{
+			From:    strconv.FormatInt(msg.UserID, 10),
+			Type:    wire.Chat,
+			To:      strconv.FormatInt(session.UserID, 10),
+			Content: wire.ChatMessage{User: msg.Username, Text: msg.Text},
+		}))
+	}
 }
 
 // SetPlayerDisconnected notifies the user has left the lobby.
@@ -491,13 +1027,15 @@ func (mp *Multiplayer) SetPlayerDisconnected(session *UserSession) {
 
 	// Delete the session from the map
 	mp.DeleteUserSession(session.UserID)
+	mp.Moderation.Forget(session.UserID)
 
-	// Notify all the users
-	mp.BroadcastMessage(context.Background(), wire.Compose(wire.LeaveLobby, wire.Message{
+	// Notify all the users in the channel
+	mp.BroadcastChannelMessage(context.Background(), session.Channel, wire.Compose(wire.LeaveLobby, wire.Message{
 		Type:    wire.LeaveLobby,
 		From:    strconv.Itoa(int(session.UserID)),
 		Content: session.ToPlayer(),
 	}))
+	mp.NotifyFriends(context.Background(), session.UserID, fmt.Sprintf("Your friend %s went offline", session.User.Username))
 }
 
 // BroadcastMessage sends a message to all connected users.
@@ -510,6 +1048,151 @@ func (mp *Multiplayer) BroadcastMessage(ctx context.Context, payload []byte) {
 	})
 }
 
+// SendPrivateMessage delivers the message to the user with the given name,
+// wherever the user is in the lobby. The sender receives the copy of the
+// delivered message or the notice that the recipient is not online.
+func (mp *Multiplayer) SendPrivateMessage(ctx context.Context, sender *UserSession, msg wire.Whisper) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	msg.From = sender.User.Username
+
+	text, err := mp.moderateChat(ctx, sender, msg.Text)
+	if err != nil {
+		return err
+	}
+	msg.Text = text
+
+	recipient, found := mp.GetUserSessionByName(msg.To)
+	if !found {
+		sender.Send(ctx, wire.ComposeTyped(wire.PrivateMessageFailed, wire.MessageContent[wire.Whisper]{
+			Type:    wire.PrivateMessageFailed,
+			To:      strconv.FormatInt(sender.UserID, 10),
+			Content: msg,
+		}))
+		return fmt.Errorf("%w: %s", ErrSessionNotFound, msg.To)
+	}
+	msg.To = recipient.User.Username
+
+	payload := wire.ComposeTyped(wire.PrivateMessage, wire.MessageContent[wire.Whisper]{
+		From:    strconv.FormatInt(sender.UserID, 10),
+		Type:    wire.PrivateMessage,
+		To:      strconv.FormatInt(recipient.UserID, 10),
+		Content: msg,
+	})
+	recipient.Send(ctx, payload)
+	if recipient != sender {
+		sender.Send(ctx, payload)
+	}
+	return nil
+}
+
+// BroadcastChannelMessage sends a message to all users present in the channel.
+func (mp *Multiplayer) BroadcastChannelMessage(ctx context.Context, channel string, payload []byte) {
+	mp.forEachSession(func(session *UserSession) bool {
+		if session.Channel == channel {
+			session.Send(ctx, payload)
+		}
+		return true
+	})
+}
+
+// CountChannelSessions returns the number of users present in the channel.
+func (mp *Multiplayer) CountChannelSessions(channel string) int {
+	count := 0
+	mp.forEachSession(func(session *UserSession) bool {
+		if session.Channel == channel {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+// KickUser disconnects the user from the lobby and the relay server. The
+// session is cleaned up by SetPlayerDisconnected, once the connection is
+// closed.
+func (mp *Multiplayer) KickUser(userId int64, reason string) error {
+	session, found := mp.GetUserSession(userId)
+	if !found {
+		return fmt.Errorf("%w: %d", ErrSessionNotFound, userId)
+	}
+
+	if mp.Relay != nil {
+		mp.Relay.Server.kickPeer(strconv.FormatInt(userId, 10))
+	}
+	if reason == "" {
+		reason = "kicked by admin"
+	}
+	// The close frame cannot carry more than 123 bytes of the reason.
+	if len(reason) > 123 {
+		reason = reason[:123]
+	}
+	return session.wsConn.Close(websocket.StatusPolicyViolation, reason)
+}
+
+// SetUserRole updates the role of the connected user. It does nothing when
+// the user is not in the lobby.
+func (mp *Multiplayer) SetUserRole(userId int64, role wire.Role) {
+	mp.sessionMutex.Lock()
+	defer mp.sessionMutex.Unlock()
+
+	if session, found := mp.sessions[userId]; found {
+		session.User.Role = role
+	}
+}
+
+// BroadcastSystemMessage sends a message from the server to all connected
+// users and returns the number of recipients.
+func (mp *Multiplayer) BroadcastSystemMessage(ctx context.Context, text string) int {
+	payload := wire.ComposeTyped(wire.SystemMessage, wire.MessageContent[wire.ChatMessage]{
+		Type:    wire.SystemMessage,
+		Content: wire.ChatMessage{User: "System", Text: text},
+	})
+
+	recipients := 0
+	mp.forEachSession(func(session *UserSession) bool {
+		session.Send(ctx, payload)
+		recipients++
+		return true
+	})
+	return recipients
+}
+
+// DrainBackends asks the backends of all connected users to warn their players
+// and shut down, once the countdown ends. It returns the number of notified
+// sessions.
+func (mp *Multiplayer) DrainBackends(ctx context.Context, countdown time.Duration, reason string) int {
+	payload := wire.ComposeTyped(wire.DrainBackend, wire.MessageContent[wire.Drain]{
+		Type:    wire.DrainBackend,
+		Content: wire.Drain{Countdown: countdown, Reason: reason},
+	})
+
+	sessions := 0
+	mp.forEachSession(func(session *UserSession) bool {
+		session.Send(ctx, payload)
+		sessions++
+		return true
+	})
+	return sessions
+}
+
+// ListSessions returns the sessions of all users connected to the lobby,
+// ordered by the user ID.
+func (mp *Multiplayer) ListSessions() []*UserSession {
+	mp.sessionMutex.RLock()
+	defer mp.sessionMutex.RUnlock()
+
+	list := make([]*UserSession, 0, len(mp.sessions))
+	for _, session := range mp.sessions {
+		list = append(list, session)
+	}
+	slices.SortFunc(list, func(a, b *UserSession) int {
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+	return list
+}
+
 // GetUserSession is a thread-safe method to receive a session by ID.
 func (mp *Multiplayer) GetUserSession(id int64) (*UserSession, bool) {
 	mp.sessionMutex.RLock()
@@ -518,6 +1201,42 @@ func (mp *Multiplayer) GetUserSession(id int64) (*UserSession, bool) {
 	return member, ok
 }
 
+// UserPresence tells where the connected user is in the lobby.
+type UserPresence struct {
+	Channel string
+	GameID  string
+}
+
+// GetUserPresence returns the channel and the game room of the connected user.
+// They are copied under the locks guarding them, so the presence of the other
+// users can be read while they move around the lobby.
+func (mp *Multiplayer) GetUserPresence(id int64) (UserPresence, bool) {
+	mp.roomsMutex.RLock()
+	defer mp.roomsMutex.RUnlock()
+	mp.sessionMutex.RLock()
+	defer mp.sessionMutex.RUnlock()
+
+	member, ok := mp.sessions[id]
+	if !ok {
+		return UserPresence{}, false
+	}
+	return UserPresence{Channel: member.Channel, GameID: member.GameID}, true
+}
+
+// GetUserSessionByName is a thread-safe method to receive a session by the
+// username, regardless of the letter case.
+func (mp *Multiplayer) GetUserSessionByName(username string) (*UserSession, bool) {
+	var member *UserSession
+	mp.forEachSession(func(session *UserSession) bool {
+		if strings.EqualFold(session.User.Username, username) {
+			member = session
+			return false
+		}
+		return true
+	})
+	return member, member != nil
+}
+
 // AddUserSession is a thread-safe operation to add a session identified by ID.
 func (mp *Multiplayer) AddUserSession(id int64, session *UserSession) {
 	if _, exists := mp.GetUserSession(id); exists {
@@ -546,16 +1265,17 @@ func (mp *Multiplayer) forEachSession(f func(session *UserSession) bool) {
 	}
 }
 
-// listSession is a thread-safe method to retrieve the session list.
-func (mp *Multiplayer) listSessions() []wire.Player {
+// listChannelSessions is a thread-safe method to retrieve the list of players
+// present in the channel.
+func (mp *Multiplayer) listChannelSessions(channel string) []wire.Player {
 	mp.sessionMutex.RLock()
 	defer mp.sessionMutex.RUnlock()
 
-	list := make([]wire.Player, len(mp.sessions))
-	i := 0
+	list := make([]wire.Player, 0, len(mp.sessions))
 	for _, session := range mp.sessions {
-		list[i] = session.ToPlayer()
-		i++
+		if session.Channel == channel {
+			list = append(list, session.ToPlayer())
+		}
 	}
 	return list
 }
@@ -0,0 +1,137 @@
+package console
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+type helloConn struct {
+	recordingConn
+	hello []byte
+}
+
+func (c *helloConn) Read(ctx context.Context) (websocket.MessageType, []byte, error) {
+	return websocket.MessageText, c.hello, nil
+}
+
+func TestMultiplayer_HandleHello(t *testing.T) {
+	hello := func(user wire.User) *helloConn {
+		return &helloConn{hello: wire.ComposeTyped(wire.Hello, wire.MessageContent[wire.User]{From: user.ID(), Content: user})}
+	}
+
+	t.Run("user is not taken from the client", func(t *testing.T) {
+		conn := hello(wire.User{UserID: 1, Username: "admin", Version: wire.ProtoVersion, Role: wire.RoleAdmin})
+
+		session := NewUserSession(1, conn)
+		session.User = wire.User{UserID: 1, Username: "archer", Role: wire.RoleModerator}
+
+		assert.NoError(t, NewMultiplayer().HandleHello(t.Context(), session))
+		assert.Equal(t, wire.User{UserID: 1, Username: "archer", Version: wire.ProtoVersion, Role: wire.RoleModerator}, session.User)
+		assert.True(t, session.HasRole(wire.RoleModerator))
+		assert.False(t, session.HasRole(wire.RoleAdmin))
+	})
+
+	t.Run("hello of another user is rejected", func(t *testing.T) {
+		conn := hello(wire.User{UserID: 2, Username: "mage", Version: wire.ProtoVersion})
+
+		session := NewUserSession(1, conn)
+		session.User = wire.User{UserID: 1, Username: "archer"}
+
+		assert.ErrorIs(t, NewMultiplayer().HandleHello(t.Context(), session), errUserIDMismatch)
+		assert.Equal(t, "archer", session.User.Username)
+		assert.Empty(t, conn.written, "no welcome is sent")
+	})
+}
+
+func TestMultiplayer_SendPrivateMessage(t *testing.T) {
+	mp := NewMultiplayer()
+
+	newSession := func(userID int64, username, channel string) (*UserSession, *recordingConn) {
+		conn := &recordingConn{}
+		session := NewUserSession(userID, conn)
+		session.User = wire.User{UserID: userID, Username: username}
+		session.Channel = channel
+		mp.AddUserSession(userID, session)
+		return session, conn
+	}
+	received := func(t *testing.T, conn *recordingConn) []wire.MessageContent[wire.Whisper] {
+		t.Helper()
+		conn.mu.Lock()
+		defer conn.mu.Unlock()
+		var messages []wire.MessageContent[wire.Whisper]
+		for _, payload := range conn.written {
+			_, msg, err := wire.DecodeTyped[wire.Whisper](payload)
+			assert.NoError(t, err)
+			messages = append(messages, msg)
+		}
+		conn.written = nil
+		return messages
+	}
+
+	sender, senderConn := newSession(1, "archer", "DISPEL")
+	_, recipientConn := newSession(2, "Mage", "PVP")
+	_, otherConn := newSession(3, "knight", "DISPEL")
+
+	t.Run("delivered to the recipient only", func(t *testing.T) {
+		assert.NoError(t, mp.SendPrivateMessage(t.Context(), sender, wire.Whisper{From: "spoofed", To: "mage", Text: "hi"}))
+
+		expected := wire.Whisper{From: "archer", To: "Mage", Text: "hi"}
+		if messages := received(t, recipientConn); assert.Len(t, messages, 1) {
+			assert.Equal(t, wire.PrivateMessage, messages[0].Type)
+			assert.Equal(t, expected, messages[0].Content)
+		}
+		if messages := received(t, senderConn); assert.Len(t, messages, 1) {
+			assert.Equal(t, expected, messages[0].Content)
+		}
+		assert.Empty(t, received(t, otherConn))
+	})
+
+	t.Run("recipient is offline", func(t *testing.T) {
+		assert.ErrorIs(t, mp.SendPrivateMessage(t.Context(), sender, wire.Whisper{To: "nobody", Text: "hi"}), ErrSessionNotFound)
+
+		if messages := received(t, senderConn); assert.Len(t, messages, 1) {
+			assert.Equal(t, wire.PrivateMessageFailed, messages[0].Type)
+			assert.Equal(t, "nobody", messages[0].Content.To)
+		}
+		assert.Empty(t, received(t, otherConn))
+	})
+}
+
+func TestMultiplayer_Stop(t *testing.T) {
+	t.Run("before run", func(t *testing.T) {
+		mp := NewMultiplayer()
+		mp.Stop()
+
+		done := make(chan struct{})
+		go func() {
+			mp.Run(t.Context())
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Run has not returned after Stop")
+		}
+	})
+
+	t.Run("while running", func(t *testing.T) {
+		mp := NewMultiplayer()
+
+		done := make(chan struct{})
+		go func() {
+			mp.Run(t.Context())
+			close(done)
+		}()
+		mp.Stop()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Run has not returned after Stop")
+		}
+	})
+}
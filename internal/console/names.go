@@ -0,0 +1,112 @@
+package console
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrNameLength   = errors.New("name has invalid length")
+	ErrNameCharset  = errors.New("name contains characters not allowed")
+	ErrNameReserved = errors.New("name is reserved")
+	ErrNameDenied   = errors.New("name contains a denied word")
+)
+
+// DefaultReservedNames are the names used by the server in the messages sent
+// to the players, which must not be impersonated.
+var DefaultReservedNames = []string{
+	"admin",
+	"administrator",
+	"moderator",
+	"server",
+	"system",
+	"system-info",
+}
+
+// NamePolicy restricts the user and the character names that can be
+// registered. The game client sends the names as single-byte strings, so only
+// the ASCII letters, digits and the allowed symbols are accepted.
+type NamePolicy struct {
+	MinLength int
+	MaxLength int
+
+	// AllowedSymbols lists the characters allowed next to letters and digits.
+	AllowedSymbols string
+
+	// Reserved names cannot be registered, regardless of the letter case.
+	Reserved []string
+
+	// Denied words cannot be a part of the name, regardless of the letter
+	// case and the symbols placed between the letters.
+	Denied []string
+}
+
+// Validate returns an error when the name does not satisfy the policy.
+// No restrictions are applied when the policy is nil.
+func (p *NamePolicy) Validate(name string) error {
+	if p == nil {
+		return nil
+	}
+
+	if len(name) < p.MinLength || len(name) > p.MaxLength {
+		return fmt.Errorf("%w: must be between %d and %d characters", ErrNameLength, p.MinLength, p.MaxLength)
+	}
+	for i := 0; i < len(name); i++ {
+		if !isNameChar(name[i]) && strings.IndexByte(p.AllowedSymbols, name[i]) < 0 {
+			return fmt.Errorf("%w: %q", ErrNameCharset, name[i])
+		}
+	}
+
+	lower := strings.ToLower(name)
+	if slices.ContainsFunc(p.Reserved, func(reserved string) bool {
+		return strings.EqualFold(reserved, name)
+	}) {
+		return ErrNameReserved
+	}
+
+	letters := strings.Map(func(r rune) rune {
+		if r < 0x80 && isNameChar(byte(r)) {
+			return r
+		}
+		return -1
+	}, lower)
+	for _, word := range p.Denied {
+		word = strings.ToLower(word)
+		if strings.Contains(lower, word) || strings.Contains(letters, word) {
+			return ErrNameDenied
+		}
+	}
+	return nil
+}
+
+func isNameChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// LoadDenyList reads the denied words from the file, one per line. Empty
+// lines and lines starting with "#" are skipped.
+func LoadDenyList(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open the deny list: %w", err)
+	}
+	defer f.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read the deny list: %w", err)
+	}
+	return words, nil
+}
@@ -0,0 +1,59 @@
+package console
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamePolicy_Validate(t *testing.T) {
+	policy := &NamePolicy{
+		MinLength:      2,
+		MaxLength:      8,
+		AllowedSymbols: "-_",
+		Reserved:       DefaultReservedNames,
+		Denied:         []string{"badword"},
+	}
+
+	for _, tc := range []struct {
+		name string
+		err  error
+	}{
+		{"user", nil},
+		{"Tester_1", nil},
+		{"a", ErrNameLength},
+		{"longername", ErrNameLength},
+		{"us er", ErrNameCharset},
+		{"user\x01", ErrNameCharset},
+		{"\xb0\xa1\xb0\xa1", ErrNameCharset},
+		{"SYSTEM", ErrNameReserved},
+		{"Admin", ErrNameReserved},
+		{"xBadWord", ErrNameDenied},
+		{"ba_dword", ErrNameDenied},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, policy.Validate(tc.name), tc.err)
+		})
+	}
+
+	t.Run("nil policy", func(t *testing.T) {
+		var policy *NamePolicy
+		assert.NoError(t, policy.Validate("system-info"))
+	})
+}
+
+func TestLoadDenyList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deny.txt")
+	if err := os.WriteFile(path, []byte("# comment\n\nfoo\n  bar \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	words, err := LoadDenyList(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, words)
+
+	_, err = LoadDenyList(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
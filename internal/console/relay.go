@@ -3,6 +3,8 @@ package console
 import (
 	"context"
 	"fmt"
+
+	"github.com/dimspell/gladiator/internal/console/auth"
 )
 
 type Relay struct {
@@ -10,8 +12,8 @@ type Relay struct {
 	cancel context.CancelFunc
 }
 
-func NewRelay(addr string, multiplayer *Multiplayer) (*Relay, error) {
-	server, err := NewQUICRelay(addr, multiplayer)
+func NewRelay(addr string, multiplayer *Multiplayer, sessions *auth.SessionSigner, bans *BanList, certs *CertReloader) (*Relay, error) {
+	server, err := NewQUICRelay(addr, multiplayer, sessions, bans, certs)
 	if err != nil {
 		return nil, fmt.Errorf("relay failed to listen: %v", err)
 	}
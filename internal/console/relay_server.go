@@ -1,7 +1,6 @@
 package console
 
 import (
-	"bytes"
 	"context"
 	"crypto/tls"
 	"encoding/json"
@@ -16,6 +15,7 @@ import (
 	"time"
 
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
+	"github.com/dimspell/gladiator/internal/console/auth"
 	"github.com/dimspell/gladiator/internal/metrics"
 	"github.com/quic-go/quic-go"
 )
@@ -39,6 +39,84 @@ type RelayPacket struct {
 	FromID  string `json:"from"`
 	ToID    string `json:"to,omitempty"`
 	Payload []byte `json:"payload"`
+
+	// Seq is a sequence number of the packet sent over the stream. It must
+	// increase with every packet, otherwise the packet is treated as replayed.
+	Seq uint64 `json:"seq"`
+
+	// Signature is an HMAC-SHA256 of the packet (without the signature),
+	// computed with the relay key issued for the session.
+	Signature []byte `json:"sig,omitempty"`
+}
+
+// RelayErrorCode is sent to the peer as the QUIC application error code, when
+// the relay server closes its connection.
+type RelayErrorCode uint64
+
+const (
+	// RelayCodeClosed is used when the peer leaves the room or disconnects.
+	RelayCodeClosed RelayErrorCode = 0xdead
+
+	// RelayCodeBadHandshake is used when the join packet is malformed.
+	RelayCodeBadHandshake RelayErrorCode = 0x100
+
+	// RelayCodeUnauthenticated is used when the join packet is not signed
+	// correctly or the session of the user is not valid.
+	RelayCodeUnauthenticated RelayErrorCode = 0x101
+
+	// RelayCodeRoomNotFound is used when the game room does not exist.
+	RelayCodeRoomNotFound RelayErrorCode = 0x102
+
+	// RelayCodeNotRoomMember is used when the peer is neither the host nor
+	// a player who has joined the game room.
+	RelayCodeNotRoomMember RelayErrorCode = 0x103
+
+	// RelayCodeKicked is used when the peer has been kicked by an admin or
+	// by the host of the game room.
+	RelayCodeKicked RelayErrorCode = 0x104
+
+	// RelayCodeRoomClosed is used when the game room has been destroyed by
+	// an admin.
+	RelayCodeRoomClosed RelayErrorCode = 0x105
+
+	// RelayCodeBanned is used when the user or its IP address is banned.
+	RelayCodeBanned RelayErrorCode = 0x106
+)
+
+func (c RelayErrorCode) String() string {
+	switch c {
+	case RelayCodeClosed:
+		return "closed"
+	case RelayCodeBadHandshake:
+		return "bad handshake"
+	case RelayCodeUnauthenticated:
+		return "unauthenticated"
+	case RelayCodeRoomNotFound:
+		return "room not found"
+	case RelayCodeNotRoomMember:
+		return "not a room member"
+	case RelayCodeKicked:
+		return "kicked"
+	case RelayCodeRoomClosed:
+		return "room closed"
+	case RelayCodeBanned:
+		return "banned"
+	default:
+		return fmt.Sprintf("unknown (0x%x)", uint64(c))
+	}
+}
+
+// RelayError is returned when the peer is rejected by the relay server.
+type RelayError struct {
+	Code RelayErrorCode
+	Err  error
+}
+
+func (e *RelayError) Error() string { return fmt.Sprintf("%s: %s", e.Code, e.Err) }
+func (e *RelayError) Unwrap() error { return e.Err }
+
+func newRelayError(code RelayErrorCode, format string, args ...any) error {
+	return &RelayError{Code: code, Err: fmt.Errorf(format, args...)}
 }
 
 type PeerConn struct {
@@ -59,6 +137,15 @@ type PeerConn struct {
 	LastSeen time.Time
 
 	Session *UserSession
+
+	// key is the relay key of the peer session, used to verify incoming and
+	// sign outgoing packets.
+	key []byte
+
+	// recvSeq and sendSeq are the sequence numbers of the last packet
+	// received from and sent to the peer.
+	recvSeq uint64
+	sendSeq uint64
 }
 
 type Room struct {
@@ -74,6 +161,8 @@ type RelayServer struct {
 	logger        *slog.Logger
 
 	Multiplayer *Multiplayer
+	Sessions    *auth.SessionSigner
+	Bans        *BanList
 
 	Events chan RelayEvent
 }
@@ -84,11 +173,10 @@ type RelayEvent struct {
 	RoomID string
 }
 
-func NewQUICRelay(addr string, multiplayer *Multiplayer) (*RelayServer, error) {
+func NewQUICRelay(addr string, multiplayer *Multiplayer, sessions *auth.SessionSigner, bans *BanList, certs *CertReloader) (*RelayServer, error) {
 	tlsConf := &tls.Config{
-		InsecureSkipVerify: true,
-		NextProtos:         []string{"game-relay"},
-		Certificates:       []tls.Certificate{generateSelfSigned()},
+		NextProtos:     []string{"game-relay"},
+		GetCertificate: certs.GetCertificate,
 	}
 
 	listener, err := quic.ListenAddr(addr, tlsConf, &quic.Config{
@@ -105,6 +193,8 @@ func NewQUICRelay(addr string, multiplayer *Multiplayer) (*RelayServer, error) {
 		peerToRoomIDs: make(map[string]string),
 		logger:        slog.With(slog.String("component", "relay")),
 		Multiplayer:   multiplayer,
+		Sessions:      sessions,
+		Bans:          bans,
 		Events:        make(chan RelayEvent),
 	}, nil
 }
@@ -133,64 +223,93 @@ func (rs *RelayServer) handleConn(ctx context.Context, conn RelayConn) {
 		return
 	}
 
-	peerID, roomID, err := rs.handshake(stream)
+	decoder := json.NewDecoder(stream)
+
+	join, key, err := rs.handshake(ctx, decoder, remoteIP(conn.RemoteAddr().String()))
 	if err != nil {
 		rs.logger.Warn("Relay handshake error", logging.Error(err))
-		rs.closeStream(conn, stream)
+
+		code := RelayCodeBadHandshake
+		var relayErr *RelayError
+		if errors.As(err, &relayErr) {
+			code = relayErr.Code
+		}
+		rs.closeStream(conn, stream, code)
 		return
 	}
 
 	metrics.PacketIn.Inc()
 
-	peer := rs.joinRoom(roomID, peerID, conn, stream)
+	peer := rs.joinRoom(join.RoomID, join.FromID, key, conn, stream)
+	peer.recvSeq = join.Seq
 
-	go rs.relayLoop(roomID, peerID, peer)
+	go rs.relayLoop(join.RoomID, join.FromID, peer, decoder)
 }
 
-// closeStream closes the stream and connection abruptly
-func (rs *RelayServer) closeStream(conn RelayConn, stream RelayStream) {
-	// TODO: Name and handle various error codes
-	var errorCode quic.StreamErrorCode = 0xdead
+// closeStream closes the stream and connection abruptly with the given code.
+func (rs *RelayServer) closeStream(conn RelayConn, stream RelayStream, code RelayErrorCode) {
+	stream.CancelWrite(quic.StreamErrorCode(code))
+	stream.CancelRead(quic.StreamErrorCode(code))
+	_ = conn.CloseWithError(quic.ApplicationErrorCode(code), code.String())
 
-	stream.CancelWrite(errorCode)
-	stream.CancelRead(errorCode)
-	_ = conn.CloseWithError(0xdead, "done")
-
-	slog.Info("Closed relay connection", "addr", conn.RemoteAddr())
+	slog.Info("Closed relay connection", "addr", conn.RemoteAddr(), "code", code.String())
 }
 
-func (rs *RelayServer) handshake(stream RelayStream) (string, string, error) {
-	// Initial handshake: receive signed join a packet
-	buf := make([]byte, 128)
-	n, err := stream.Read(buf)
-	if err != nil {
-		return "", "", fmt.Errorf("error reading stream: %w", err)
+// handshake reads the initial "join" packet. The payload of the packet holds
+// the session token of the user, which is used to derive the relay key the
+// packet must be signed with.
+func (rs *RelayServer) handshake(ctx context.Context, decoder *json.Decoder, ip string) (RelayPacket, []byte, error) {
+	var pkt RelayPacket
+	if err := decoder.Decode(&pkt); err != nil {
+		return pkt, nil, newRelayError(RelayCodeBadHandshake, "error reading join packet: %w", err)
+	}
+	if pkt.Type != "join" {
+		return pkt, nil, newRelayError(RelayCodeBadHandshake, "invalid join packet")
 	}
 
-	data, ok := verify(buf[:n])
-	if !ok {
-		return "", "", fmt.Errorf("signature failed from client")
+	token := string(pkt.Payload)
+	userID, err := rs.Sessions.Verify(token)
+	if err != nil {
+		return pkt, nil, newRelayError(RelayCodeUnauthenticated, "invalid session token: %w", err)
+	}
+	if strconv.FormatInt(userID, 10) != pkt.FromID {
+		return pkt, nil, newRelayError(RelayCodeUnauthenticated, "session token does not belong to the peer %q", pkt.FromID)
 	}
 
-	var pkt RelayPacket
-	if err := json.Unmarshal(data, &pkt); err != nil {
-		return "", "", fmt.Errorf("error unmarshaling packet: %w", err)
+	key := rs.Sessions.RelayKey(token)
+	if !verify(key, pkt) {
+		metrics.PacketDropped.WithLabelValues("signature").Inc()
+		return pkt, nil, newRelayError(RelayCodeUnauthenticated, "signature failed from client")
 	}
-	if pkt.Type != "join" {
-		return "", "", fmt.Errorf("invalid join packet")
+	if pkt.Seq == 0 {
+		metrics.PacketDropped.WithLabelValues("replay").Inc()
+		return pkt, nil, newRelayError(RelayCodeUnauthenticated, "missing sequence number")
 	}
 
-	userID, _ := strconv.ParseInt(pkt.FromID, 10, 64)
 	if _, ok := rs.Multiplayer.GetUserSession(userID); !ok {
-		return "", "", fmt.Errorf("failed to get user session")
+		return pkt, nil, newRelayError(RelayCodeUnauthenticated, "failed to get user session")
+	}
+	if err := rs.Bans.Check(ctx, userID, ip); err != nil {
+		var banErr *BanError
+		if errors.As(err, &banErr) {
+			return pkt, nil, newRelayError(RelayCodeBanned, "%w", err)
+		}
+		return pkt, nil, newRelayError(RelayCodeUnauthenticated, "could not check bans: %w", err)
 	}
 
-	// TODO: Authenticate & authorize
+	// Only the host and the players who have joined the game room (and thus
+	// passed the password check) are allowed to relay the traffic.
+	if err := rs.Multiplayer.AuthorizeRoomMember(pkt.RoomID, userID); err != nil {
+		if errors.Is(err, ErrRoomNotFound) {
+			return pkt, nil, newRelayError(RelayCodeRoomNotFound, "%w", err)
+		}
+		return pkt, nil, newRelayError(RelayCodeNotRoomMember, "%w", err)
+	}
 
-	return pkt.FromID, pkt.RoomID, nil
+	return pkt, key, nil
 }
 
-func (rs *RelayServer) joinRoom(roomID, peerID string, conn RelayConn, stream RelayStream) *PeerConn {
+func (rs *RelayServer) joinRoom(roomID, peerID string, key []byte, conn RelayConn, stream RelayStream) *PeerConn {
 	rs.mu.Lock()
 	defer rs.mu.Unlock()
 
@@ -208,6 +327,7 @@ func (rs *RelayServer) joinRoom(roomID, peerID string, conn RelayConn, stream Re
 		Stream:   stream,
 		Conn:     conn,
 		LastSeen: time.Now(),
+		key:      key,
 	}
 	room.Peers[peerID] = pc
 	rs.peerToRoomIDs[peerID] = roomID
@@ -219,7 +339,7 @@ func (rs *RelayServer) joinRoom(roomID, peerID string, conn RelayConn, stream Re
 			continue
 		}
 
-		rs.sendSigned(peer.Stream, RelayPacket{
+		rs.sendSigned(peer, RelayPacket{
 			Type:    "join",
 			RoomID:  roomID,
 			FromID:  peerID,
@@ -238,67 +358,63 @@ func (rs *RelayServer) joinRoom(roomID, peerID string, conn RelayConn, stream Re
 	return pc
 }
 
-func (rs *RelayServer) relayLoop(roomID, peerID string, peer *PeerConn) {
+func (rs *RelayServer) relayLoop(roomID, peerID string, peer *PeerConn, decoder *json.Decoder) {
 	metrics.ConnectedPeers.Inc()
 	defer metrics.ConnectedPeers.Dec()
 
-	buf := make([]byte, 4096)
-
 	for {
-		n, err := peer.Stream.Read(buf)
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
+		var pkt RelayPacket
+		if err := decoder.Decode(&pkt); err != nil {
+			if err == io.EOF {
+				break
+			}
 			var se *quic.StreamError
-			if ok := errors.As(err, &se); ok && se.ErrorCode == 0xdead {
+			if ok := errors.As(err, &se); ok && RelayErrorCode(se.ErrorCode) == RelayCodeClosed {
 				break
 			}
 			rs.logger.Warn("stream error when reading", logging.Error(err), logging.PeerID(peerID))
 			break
 		}
+		metrics.PacketIn.Inc()
 
-		data, ok := verify(buf[:n])
-		if !ok {
+		if !verify(peer.key, pkt) {
 			rs.logger.Warn("signature check failed when reading", logging.PeerID(peerID))
+			metrics.PacketDropped.WithLabelValues("signature").Inc()
 			continue
 		}
-
+		if pkt.Seq <= peer.recvSeq {
+			rs.logger.Warn("replayed packet dropped", logging.PeerID(peerID), "seq", pkt.Seq, "lastSeq", peer.recvSeq)
+			metrics.PacketDropped.WithLabelValues("replay").Inc()
+			continue
+		}
+		if pkt.FromID != peerID {
+			rs.logger.Warn("spoofed sender dropped", logging.PeerID(peerID), "from", pkt.FromID)
+			metrics.PacketDropped.WithLabelValues("sender").Inc()
+			continue
+		}
+		if pkt.RoomID != roomID {
+			rs.logger.Warn("packet for other room dropped", logging.PeerID(peerID), logging.RoomID(pkt.RoomID))
+			metrics.PacketDropped.WithLabelValues("room").Inc()
+			continue
+		}
+		peer.recvSeq = pkt.Seq
 		peer.LastSeen = time.Now()
 
-		d := json.NewDecoder(bytes.NewReader(data))
-		for {
-			var pkt RelayPacket
-			if err := d.Decode(&pkt); err != nil {
-				if err == io.EOF {
-					// TODO: Maybe clear(buf) is needed?
-					break
-				}
-				rs.logger.Warn("relay packet unmarshal error", logging.Error(err), logging.PeerID(peerID))
-				break
-			}
-			metrics.PacketIn.Inc()
-
-			// if pkt.Type != "ping" {
-			rs.logger.Debug("[RELAY]", "payload", pkt.Payload, "from", pkt.FromID, "to", pkt.ToID, "type", pkt.Type)
-			// }
+		// if pkt.Type != "ping" {
+		rs.logger.Debug("[RELAY]", "payload", pkt.Payload, "from", pkt.FromID, "to", pkt.ToID, "type", pkt.Type)
+		// }
 
-			switch pkt.Type {
-			case "udp", "tcp":
-				rs.sendTo(pkt.RoomID, pkt.ToID, pkt)
+		switch pkt.Type {
+		case "udp", "tcp":
+			rs.sendTo(roomID, pkt.ToID, pkt)
 
-			case "broadcast":
-				rs.broadcastFrom(pkt.RoomID, pkt.FromID, pkt)
+		case "broadcast":
+			rs.broadcastFrom(roomID, peerID, pkt)
 
-			case "leave":
-				if pkt.FromID != peerID && pkt.RoomID != roomID {
-					continue
-				}
-
-				slog.Info("leave room", logging.PeerID(peerID))
-				rs.leaveRoom(peerID, roomID)
-				return
-			}
+		case "leave":
+			slog.Info("leave room", logging.PeerID(peerID))
+			rs.leaveRoom(peerID, roomID)
+			return
 		}
 	}
 
@@ -307,6 +423,37 @@ func (rs *RelayServer) relayLoop(roomID, peerID string, peer *PeerConn) {
 }
 
 func (rs *RelayServer) leaveRoom(peerID, roomID string) {
+	rs.removePeer(peerID, roomID, RelayCodeClosed)
+}
+
+// kickPeer disconnects the peer from the room it is connected to.
+func (rs *RelayServer) kickPeer(peerID string) {
+	rs.mu.Lock()
+	roomID, ok := rs.peerToRoomIDs[peerID]
+	rs.mu.Unlock()
+	if !ok {
+		return
+	}
+	rs.removePeer(peerID, roomID, RelayCodeKicked)
+}
+
+// closeRoom disconnects all the peers connected to the room.
+func (rs *RelayServer) closeRoom(roomID string) {
+	rs.mu.Lock()
+	var peerIDs []string
+	if room, ok := rs.rooms[roomID]; ok {
+		for peerID := range room.Peers {
+			peerIDs = append(peerIDs, peerID)
+		}
+	}
+	rs.mu.Unlock()
+
+	for _, peerID := range peerIDs {
+		rs.removePeer(peerID, roomID, RelayCodeRoomClosed)
+	}
+}
+
+func (rs *RelayServer) removePeer(peerID, roomID string, code RelayErrorCode) {
 	rs.mu.Lock()
 	defer rs.mu.Unlock()
 
@@ -325,7 +472,7 @@ func (rs *RelayServer) leaveRoom(peerID, roomID string) {
 		return
 	}
 
-	rs.closeStream(leaver.Conn, leaver.Stream)
+	rs.closeStream(leaver.Conn, leaver.Stream, code)
 	delete(room.Peers, peerID)
 
 	rs.Events <- RelayEvent{
@@ -392,7 +539,7 @@ func (rs *RelayServer) sendTo(roomID, peerID string, pkt RelayPacket) {
 		return
 	}
 
-	rs.sendSigned(peer.Stream, pkt)
+	rs.sendSigned(peer, pkt)
 }
 
 func (rs *RelayServer) broadcastFrom(roomID, fromID string, pkt RelayPacket) {
@@ -408,18 +555,27 @@ func (rs *RelayServer) broadcastFrom(roomID, fromID string, pkt RelayPacket) {
 		if id == fromID {
 			continue
 		}
-		rs.sendSigned(peer.Stream, pkt)
+		rs.sendSigned(peer, pkt)
 	}
 }
 
-func (rs *RelayServer) sendSigned(stream RelayStream, pkt RelayPacket) {
+// sendSigned signs the packet with the relay key of the recipient and writes
+// it to its stream. It must be called with the rs.mu held.
+func (rs *RelayServer) sendSigned(peer *PeerConn, pkt RelayPacket) {
+	peer.sendSeq++
+	pkt.Seq = peer.sendSeq
+	if err := sign(peer.key, &pkt); err != nil {
+		slog.Error("could not sign the packet", logging.Error(err))
+		return
+	}
+
 	data, err := json.Marshal(pkt)
 	if err != nil {
 		slog.Error("json marshal failed", logging.Error(err))
+		return
 	}
-	// packet := sign(data)
 	data = append(data, '\n')
-	if _, err := stream.Write(data); err != nil {
+	if _, err := peer.Stream.Write(data); err != nil {
 		slog.Error("could not write the msg", logging.Error(err))
 		return
 	}
@@ -3,11 +3,14 @@ package console
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"net"
 	"testing"
 	"time"
 
+	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
 	"github.com/dimspell/gladiator/internal/app/logger"
+	"github.com/dimspell/gladiator/internal/console/auth"
 	"github.com/quic-go/quic-go"
 	"github.com/stretchr/testify/assert"
 )
@@ -96,7 +99,7 @@ func TestRelayServer_JoinRoom_NewRoom(t *testing.T) {
 	mockStream := &MockStream{}
 	mockConn := &MockConn{}
 
-	pc := rs.joinRoom("room1", "peer1", mockConn, mockStream)
+	pc := rs.joinRoom("room1", "peer1", []byte("key"), mockConn, mockStream)
 
 	assert.Equal(t, "peer1", pc.ID)
 	assert.Contains(t, rs.rooms["room1"].Peers, "peer1")
@@ -111,3 +114,198 @@ func TestRelayServer_JoinRoom_NewRoom(t *testing.T) {
 		t.Fatal("expected join event")
 	}
 }
+
+func helperSignedPacket(t *testing.T, key []byte, pkt RelayPacket) []byte {
+	t.Helper()
+
+	if err := sign(key, &pkt); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(pkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(data, '\n')
+}
+
+func TestRelayServer_Handshake(t *testing.T) {
+	sessions := auth.NewSessionSigner([]byte("secret"), time.Hour, time.Now)
+	token, err := sessions.Issue(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := sessions.RelayKey(token)
+
+	newServer := func() *RelayServer {
+		rs := &RelayServer{
+			Multiplayer: NewMultiplayer(),
+			Sessions:    sessions,
+			Bans:        NewBanList(setupDatabase(t), time.Now),
+			logger:      logger.NewDiscardLogger(),
+		}
+		rs.Multiplayer.AddUserSession(10, NewUserSession(10, nil))
+		rs.Multiplayer.AddUserSession(20, NewUserSession(20, nil))
+		if _, err := rs.Multiplayer.CreateRoom(10, "room1", "", multiv1.GameMap_FrozenLabyrinth, "127.0.0.1", 0); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := rs.Multiplayer.CreateRoom(20, "room2", "", multiv1.GameMap_FrozenLabyrinth, "127.0.0.1", 0); err != nil {
+			t.Fatal(err)
+		}
+		openRoom(t, rs.Multiplayer, "room2")
+		return rs
+	}
+
+	assertCode := func(t *testing.T, code RelayErrorCode, err error) {
+		t.Helper()
+		var relayErr *RelayError
+		if assert.ErrorAs(t, err, &relayErr) {
+			assert.Equal(t, code, relayErr.Code)
+		}
+	}
+
+	t.Run("signed join", func(t *testing.T) {
+		stream := &MockStream{}
+		stream.Reader.Write(helperSignedPacket(t, key, RelayPacket{Type: "join", RoomID: "room1", FromID: "10", Payload: []byte(token), Seq: 1}))
+
+		pkt, peerKey, err := newServer().handshake(t.Context(), json.NewDecoder(stream), "")
+		assert.NoError(t, err)
+		assert.Equal(t, "room1", pkt.RoomID)
+		assert.Equal(t, "10", pkt.FromID)
+		assert.Equal(t, key, peerKey)
+	})
+
+	t.Run("unsigned join", func(t *testing.T) {
+		stream := &MockStream{}
+		data, _ := json.Marshal(RelayPacket{Type: "join", RoomID: "room1", FromID: "10", Payload: []byte(token), Seq: 1})
+		stream.Reader.Write(data)
+
+		_, _, err := newServer().handshake(t.Context(), json.NewDecoder(stream), "")
+		assert.ErrorContains(t, err, "signature failed")
+		assertCode(t, RelayCodeUnauthenticated, err)
+	})
+
+	t.Run("signed with other key", func(t *testing.T) {
+		stream := &MockStream{}
+		stream.Reader.Write(helperSignedPacket(t, []byte("other"), RelayPacket{Type: "join", RoomID: "room1", FromID: "10", Payload: []byte(token), Seq: 1}))
+
+		_, _, err := newServer().handshake(t.Context(), json.NewDecoder(stream), "")
+		assert.ErrorContains(t, err, "signature failed")
+		assertCode(t, RelayCodeUnauthenticated, err)
+	})
+
+	t.Run("token of other peer", func(t *testing.T) {
+		stream := &MockStream{}
+		stream.Reader.Write(helperSignedPacket(t, key, RelayPacket{Type: "join", RoomID: "room1", FromID: "11", Payload: []byte(token), Seq: 1}))
+
+		_, _, err := newServer().handshake(t.Context(), json.NewDecoder(stream), "")
+		assert.ErrorContains(t, err, "does not belong")
+		assertCode(t, RelayCodeUnauthenticated, err)
+	})
+
+	t.Run("room not found", func(t *testing.T) {
+		stream := &MockStream{}
+		stream.Reader.Write(helperSignedPacket(t, key, RelayPacket{Type: "join", RoomID: "unknown", FromID: "10", Payload: []byte(token), Seq: 1}))
+
+		_, _, err := newServer().handshake(t.Context(), json.NewDecoder(stream), "")
+		assertCode(t, RelayCodeRoomNotFound, err)
+	})
+
+	t.Run("not a member of the room", func(t *testing.T) {
+		stream := &MockStream{}
+		stream.Reader.Write(helperSignedPacket(t, key, RelayPacket{Type: "join", RoomID: "room2", FromID: "10", Payload: []byte(token), Seq: 1}))
+
+		_, _, err := newServer().handshake(t.Context(), json.NewDecoder(stream), "")
+		assertCode(t, RelayCodeNotRoomMember, err)
+	})
+
+	t.Run("joined player", func(t *testing.T) {
+		rs := newServer()
+		rs.Multiplayer.LeaveRoom(t.Context(), rs.Multiplayer.sessions[10])
+		if _, err := rs.Multiplayer.JoinRoom("room2", 10, "127.0.0.2"); err != nil {
+			t.Fatal(err)
+		}
+
+		stream := &MockStream{}
+		stream.Reader.Write(helperSignedPacket(t, key, RelayPacket{Type: "join", RoomID: "room2", FromID: "10", Payload: []byte(token), Seq: 1}))
+
+		_, _, err := rs.handshake(t.Context(), json.NewDecoder(stream), "")
+		assert.NoError(t, err)
+	})
+	t.Run("banned", func(t *testing.T) {
+		rs := newServer()
+		if _, err := rs.Bans.Create(t.Context(), 0, "192.0.2.0/24", "cheating", "admin", 0); err != nil {
+			t.Fatal(err)
+		}
+
+		stream := &MockStream{}
+		stream.Reader.Write(helperSignedPacket(t, key, RelayPacket{Type: "join", RoomID: "room1", FromID: "10", Payload: []byte(token), Seq: 1}))
+
+		_, _, err := rs.handshake(t.Context(), json.NewDecoder(stream), "192.0.2.7")
+		assert.ErrorContains(t, err, "cheating")
+		assertCode(t, RelayCodeBanned, err)
+	})
+}
+
+func TestRelayServer_RelayLoop_DropsForgedAndReplayedPackets(t *testing.T) {
+	senderKey, receiverKey := []byte("sender"), []byte("receiver")
+
+	rs := &RelayServer{
+		rooms:         make(map[string]*Room),
+		peerToRoomIDs: make(map[string]string),
+		Events:        make(chan RelayEvent, 10),
+		logger:        logger.NewDiscardLogger(),
+	}
+
+	senderStream, receiverStream := &MockStream{}, &MockStream{}
+	sender := rs.joinRoom("room1", "1", senderKey, &MockConn{}, senderStream)
+	rs.joinRoom("room1", "2", receiverKey, &MockConn{}, receiverStream)
+	receiverStream.Writer.Reset()
+
+	udp := func(seq uint64, payload string) RelayPacket {
+		return RelayPacket{Type: "udp", RoomID: "room1", FromID: "1", ToID: "2", Payload: []byte(payload), Seq: seq}
+	}
+	senderStream.Reader.Write(helperSignedPacket(t, senderKey, udp(1, "first")))
+	senderStream.Reader.Write(helperSignedPacket(t, senderKey, udp(1, "replayed")))
+	senderStream.Reader.Write(helperSignedPacket(t, receiverKey, udp(2, "forged")))
+	senderStream.Reader.Write(helperSignedPacket(t, senderKey, udp(2, "second")))
+
+	rs.relayLoop("room1", "1", sender, json.NewDecoder(senderStream))
+
+	var received []string
+	d := json.NewDecoder(&receiverStream.Writer)
+	for {
+		var pkt RelayPacket
+		if err := d.Decode(&pkt); err != nil {
+			break
+		}
+		assert.True(t, verify(receiverKey, pkt), "packet should be signed with the key of the recipient")
+		received = append(received, string(pkt.Payload))
+	}
+	assert.Equal(t, []string{"first", "second"}, received)
+}
+
+func TestRelayServer_RelayLoop_DropsPacketsForOtherRooms(t *testing.T) {
+	senderKey, otherKey := []byte("sender"), []byte("other")
+
+	rs := &RelayServer{
+		rooms:         make(map[string]*Room),
+		peerToRoomIDs: make(map[string]string),
+		Events:        make(chan RelayEvent, 10),
+		logger:        logger.NewDiscardLogger(),
+	}
+
+	senderStream, otherStream := &MockStream{}, &MockStream{}
+	sender := rs.joinRoom("room1", "1", senderKey, &MockConn{}, senderStream)
+	rs.joinRoom("room2", "3", otherKey, &MockConn{}, otherStream)
+	otherStream.Writer.Reset()
+
+	senderStream.Reader.Write(helperSignedPacket(t, senderKey, RelayPacket{Type: "udp", RoomID: "room2", FromID: "1", ToID: "3", Payload: []byte("udp"), Seq: 1}))
+	senderStream.Reader.Write(helperSignedPacket(t, senderKey, RelayPacket{Type: "tcp", RoomID: "room2", FromID: "1", ToID: "3", Payload: []byte("tcp"), Seq: 2}))
+	senderStream.Reader.Write(helperSignedPacket(t, senderKey, RelayPacket{Type: "broadcast", RoomID: "room2", FromID: "1", Payload: []byte("broadcast"), Seq: 3}))
+
+	rs.relayLoop("room1", "1", sender, json.NewDecoder(senderStream))
+
+	assert.Zero(t, otherStream.Writer.Len(), "nothing should be delivered to the other room")
+	_, ok := rs.rooms["room2"].Peers["3"]
+	assert.True(t, ok)
+}
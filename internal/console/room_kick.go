@@ -0,0 +1,68 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrKickedFromRoom = errors.New("user has been kicked from the room")
+	ErrCannotKickHost = errors.New("host cannot be kicked from the room")
+)
+
+// KickFromRoom lets the host remove the player from the game room. The player
+// is told that they have left the room, so their backend tears down the
+// connections to the other players, and they cannot join the room again for
+// the KickBan period.
+func (mp *Multiplayer) KickFromRoom(ctx context.Context, roomId string, hostUserID int64, userId int64) error {
+	mp.roomsMutex.Lock()
+
+	room, found := mp.Rooms[roomId]
+	if !found {
+		mp.roomsMutex.Unlock()
+		return fmt.Errorf("%w: %s", ErrRoomNotFound, roomId)
+	}
+	if room.HostPlayer == nil || room.HostPlayer.UserID != hostUserID {
+		mp.roomsMutex.Unlock()
+		return fmt.Errorf("%w: user %d, room %s", ErrNotRoomHost, hostUserID, roomId)
+	}
+	if userId == hostUserID {
+		mp.roomsMutex.Unlock()
+		return fmt.Errorf("%w: %s", ErrCannotKickHost, roomId)
+	}
+	kicked, found := room.Players[userId]
+	if !found {
+		mp.roomsMutex.Unlock()
+		return fmt.Errorf("%w: user %d, room %s", ErrNotRoomMember, userId, roomId)
+	}
+
+	delete(room.Players, userId)
+	room.ActiveAt = mp.now()
+	mp.Matches.PlayerLeft(roomId, userId)
+	if mp.KickBan > 0 {
+		if room.kickedUntil == nil {
+			room.kickedUntil = make(map[int64]time.Time)
+		}
+		room.kickedUntil[userId] = room.ActiveAt.Add(mp.KickBan)
+	}
+	mp.persistRoom(room)
+
+	kicked.Send(ctx, composeLeaveRoom(userId, kicked))
+	for id, player := range room.Players {
+		player.Send(ctx, composeLeaveRoom(id, kicked))
+	}
+	if !mp.isDetached(kicked) {
+		kicked.GameID = ""
+	}
+	mp.roomsMutex.Unlock()
+
+	slog.Info("Player has been kicked from the game room", "gameId", roomId, "userId", userId)
+	if mp.Relay != nil {
+		mp.Relay.Server.removePeer(strconv.FormatInt(userId, 10), roomId, RelayCodeKicked)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiplayer_KickFromRoom(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*Multiplayer, *time.Time, map[int64]*recordingConn) {
+		t.Helper()
+		clock := start
+		mp := NewMultiplayer()
+		mp.now = func() time.Time { return clock }
+		mp.KickBan = 10 * time.Minute
+
+		conns := make(map[int64]*recordingConn)
+		for id, username := range map[int64]string{1: "archer", 2: "mage", 3: "knight"} {
+			conn := &recordingConn{}
+			session := NewUserSession(id, conn)
+			session.User = wire.User{UserID: id, Username: username}
+			mp.AddUserSession(id, session)
+			conns[id] = conn
+		}
+		if _, err := mp.CreateRoom(1, "room", "", v1.GameMap_AbandonedRealm, "10.0.0.1", 0); err != nil {
+			t.Fatal(err)
+		}
+		openRoom(t, mp, "room")
+		for _, id := range []int64{2, 3} {
+			if _, err := mp.JoinRoom("room", id, "10.0.0.2"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return mp, &clock, conns
+	}
+
+	t.Run("kicked player is told to leave", func(t *testing.T) {
+		mp, _, conns := setup(t)
+
+		assert.NoError(t, mp.KickFromRoom(t.Context(), "room", 1, 2))
+
+		room, _ := mp.GetRoom("room")
+		assert.NotContains(t, room.Players, int64(2))
+		mage, _ := mp.GetUserSession(2)
+		assert.Empty(t, mage.GameID)
+
+		for _, id := range []int64{1, 2, 3} {
+			if assert.Len(t, conns[id].written, 1, "user %d", id) {
+				et, msg, err := wire.DecodeTyped[wire.Player](conns[id].written[0])
+				assert.NoError(t, err)
+				assert.Equal(t, wire.LeaveRoom, et)
+				assert.Equal(t, int64(2), msg.Content.UserID)
+			}
+		}
+	})
+
+	t.Run("kicked player cannot rejoin for a while", func(t *testing.T) {
+		mp, clock, _ := setup(t)
+		assert.NoError(t, mp.KickFromRoom(t.Context(), "room", 1, 2))
+
+		*clock = start.Add(9 * time.Minute)
+		_, err := mp.JoinRoom("room", 2, "10.0.0.2")
+		assert.ErrorIs(t, err, ErrKickedFromRoom)
+
+		*clock = start.Add(10 * time.Minute)
+		_, err = mp.JoinRoom("room", 2, "10.0.0.2")
+		assert.NoError(t, err)
+	})
+
+	t.Run("only the host can kick", func(t *testing.T) {
+		mp, _, _ := setup(t)
+
+		assert.ErrorIs(t, mp.KickFromRoom(t.Context(), "room", 3, 2), ErrNotRoomHost)
+		assert.ErrorIs(t, mp.KickFromRoom(t.Context(), "room", 1, 1), ErrCannotKickHost)
+		assert.ErrorIs(t, mp.KickFromRoom(t.Context(), "room", 1, 4), ErrNotRoomMember)
+		assert.ErrorIs(t, mp.KickFromRoom(t.Context(), "missing", 1, 2), ErrRoomNotFound)
+
+		room, _ := mp.GetRoom("room")
+		assert.Len(t, room.Players, 3)
+	})
+}
+
+func TestGameServiceServer_KickPlayer(t *testing.T) {
+	g := &gameServiceServer{Multiplayer: NewMultiplayer()}
+	for _, id := range []int64{10, 5} {
+		g.Multiplayer.AddUserSession(id, NewUserSession(id, &mockConn{}))
+	}
+	if _, err := g.Multiplayer.CreateRoom(10, "room", "", v1.GameMap_FrozenLabyrinth, "192.168.100.1", 0); err != nil {
+		t.Fatal(err)
+	}
+	openRoom(t, g.Multiplayer, "room")
+	if _, err := g.Multiplayer.JoinRoom("room", 5, "192.168.100.2"); err != nil {
+		t.Fatal(err)
+	}
+	g.Multiplayer.KickBan = time.Minute
+
+	_, err := g.KickPlayer(t.Context(), connect.NewRequest(&v1.KickPlayerRequest{
+		GameRoomId:   "room",
+		HostUserId:   5,
+		PlayerUserId: 10,
+	}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = g.KickPlayer(t.Context(), connect.NewRequest(&v1.KickPlayerRequest{
+		GameRoomId:   "room",
+		HostUserId:   10,
+		PlayerUserId: 5,
+	}))
+	assert.NoError(t, err)
+
+	_, err = g.KickPlayer(t.Context(), connect.NewRequest(&v1.KickPlayerRequest{
+		GameRoomId:   "room",
+		HostUserId:   10,
+		PlayerUserId: 5,
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "the player has already left")
+
+	_, err = g.JoinGame(t.Context(), connect.NewRequest(&v1.JoinGameRequest{
+		GameRoomId: "room",
+		UserId:     5,
+		IpAddress:  "192.168.100.2",
+	}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
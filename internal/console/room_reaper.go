@@ -0,0 +1,116 @@
+package console
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/metrics"
+)
+
+// RoomReaperPolicy describes when the game rooms are considered abandoned and
+// closed by the console. A zero timeout disables the check.
+type RoomReaperPolicy struct {
+	// Interval is how often the rooms are checked. The rooms are never
+	// closed, when it is zero.
+	Interval time.Duration
+
+	// NotReady is how long the room can stay created, before the host
+	// starts it.
+	NotReady time.Duration
+
+	// HostLost is how long the room is kept, after the host has been found
+	// disconnected from the lobby.
+	HostLost time.Duration
+
+	// Idle is how long the room is kept, when nobody joins or leaves it.
+	Idle time.Duration
+}
+
+var DefaultRoomReaperPolicy = RoomReaperPolicy{
+	Interval: 30 * time.Second,
+	NotReady: 5 * time.Minute,
+	HostLost: time.Minute,
+	Idle:     12 * time.Hour,
+}
+
+// The reasons the game rooms are closed by the reaper.
+const (
+	reapNotReady = "not_ready"
+	reapHostLost = "host_lost"
+	reapIdle     = "idle"
+)
+
+// ReapRooms closes the abandoned game rooms. The players of the room are told
+// that they and the others have left, and they are disconnected from the relay
+// server.
+// It returns the number of closed rooms.
+func (mp *Multiplayer) ReapRooms(ctx context.Context) int {
+	mp.roomsMutex.Lock()
+
+	now := mp.now()
+	var reaped []string
+	for id, room := range mp.Rooms {
+		reason := mp.reapReason(room, now)
+		if reason == "" {
+			continue
+		}
+		slog.Info("Closing the abandoned game room", "gameId", id, "reason", reason)
+
+		for id, player := range room.Players {
+			// The player is told about leaving the room too, so its backend
+			// tears down the connections to the others, as when kicked.
+			player.Send(ctx, composeLeaveRoom(id, player))
+			for userID, other := range room.Players {
+				if other != player {
+					other.Send(ctx, composeLeaveRoom(userID, player))
+				}
+			}
+		}
+		for _, player := range room.Players {
+			if !mp.isDetached(player) {
+				player.GameID = ""
+			}
+		}
+		mp.DestroyRoom(id)
+		metrics.ReapedRooms.WithLabelValues(reason).Inc()
+		reaped = append(reaped, id)
+	}
+	mp.roomsMutex.Unlock()
+
+	if mp.Relay != nil {
+		for _, id := range reaped {
+			mp.Relay.Server.closeRoom(id)
+		}
+	}
+	return len(reaped)
+}
+
+// reapReason tells why the room should be closed, it is empty when the room
+// is still in use. It keeps track of since when the host is disconnected. The
+// restored rooms are left to ReconcileRooms.
+func (mp *Multiplayer) reapReason(room *GameRoom, now time.Time) string {
+	if room.restored {
+		return ""
+	}
+	policy := mp.Reaper
+
+	if room.HostPlayer == nil || mp.isDetached(room.HostPlayer) || !room.HostPlayer.Connected {
+		if room.hostLostAt.IsZero() {
+			room.hostLostAt = now
+		}
+	} else {
+		room.hostLostAt = time.Time{}
+	}
+
+	switch {
+	case policy.NotReady > 0 && room.State == v1.GameState_GameStateCreating && now.Sub(room.CreatedAt) >= policy.NotReady:
+		return reapNotReady
+	case policy.HostLost > 0 && !room.hostLostAt.IsZero() && now.Sub(room.hostLostAt) >= policy.HostLost:
+		return reapHostLost
+	case policy.Idle > 0 && now.Sub(room.ActiveAt) >= policy.Idle:
+		return reapIdle
+	}
+	return ""
+}
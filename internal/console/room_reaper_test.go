@@ -0,0 +1,122 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiplayer_ReapRooms(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*Multiplayer, *time.Time, map[int64]*recordingConn) {
+		t.Helper()
+		clock := start
+		mp := NewMultiplayer()
+		mp.now = func() time.Time { return clock }
+		mp.Reaper = RoomReaperPolicy{
+			Interval: time.Second,
+			NotReady: 5 * time.Minute,
+			HostLost: time.Minute,
+			Idle:     time.Hour,
+		}
+
+		conns := make(map[int64]*recordingConn)
+		for id, username := range map[int64]string{1: "archer", 2: "mage"} {
+			conn := &recordingConn{}
+			session := NewUserSession(id, conn)
+			session.User = wire.User{UserID: id, Username: username}
+			mp.AddUserSession(id, session)
+			conns[id] = conn
+		}
+		if _, err := mp.CreateRoom(1, "room", "", v1.GameMap_AbandonedRealm, "10.0.0.1", 0); err != nil {
+			t.Fatal(err)
+		}
+		return mp, &clock, conns
+	}
+
+	t.Run("created but never ready", func(t *testing.T) {
+		mp, clock, _ := setup(t)
+
+		*clock = start.Add(4 * time.Minute)
+		assert.Equal(t, 0, mp.ReapRooms(t.Context()))
+
+		*clock = start.Add(5 * time.Minute)
+		assert.Equal(t, 1, mp.ReapRooms(t.Context()))
+		assert.Empty(t, mp.Rooms)
+
+		host, _ := mp.GetUserSession(1)
+		assert.Empty(t, host.GameID)
+	})
+
+	t.Run("host is unreachable", func(t *testing.T) {
+		mp, clock, conns := setup(t)
+		openRoom(t, mp, "room")
+		_, err := mp.JoinRoom("room", 2, "10.0.0.2")
+		assert.NoError(t, err)
+
+		// The host session is gone, but the room has not been left.
+		mp.DeleteUserSession(1)
+		assert.Equal(t, 0, mp.ReapRooms(t.Context()))
+
+		*clock = start.Add(time.Minute)
+		assert.Equal(t, 1, mp.ReapRooms(t.Context()))
+		assert.Empty(t, mp.Rooms)
+
+		mage, _ := mp.GetUserSession(2)
+		assert.Empty(t, mage.GameID)
+		var left []int64
+		for _, written := range conns[2].written {
+			et, msg, err := wire.DecodeTyped[wire.Player](written)
+			assert.NoError(t, err)
+			assert.Equal(t, wire.LeaveRoom, et)
+			left = append(left, msg.Content.UserID)
+		}
+		assert.ElementsMatch(t, []int64{1, 2}, left, "the player is told about the host and about leaving the room")
+	})
+
+	t.Run("host reconnects in time", func(t *testing.T) {
+		mp, clock, _ := setup(t)
+		openRoom(t, mp, "room")
+		host, _ := mp.GetUserSession(1)
+
+		host.Connected = false
+		assert.Equal(t, 0, mp.ReapRooms(t.Context()))
+
+		*clock = start.Add(30 * time.Second)
+		host.Connected = true
+		assert.Equal(t, 0, mp.ReapRooms(t.Context()))
+
+		*clock = start.Add(2 * time.Minute)
+		assert.Equal(t, 0, mp.ReapRooms(t.Context()))
+		assert.Len(t, mp.Rooms, 1)
+	})
+
+	t.Run("idle", func(t *testing.T) {
+		mp, clock, _ := setup(t)
+		openRoom(t, mp, "room")
+
+		*clock = start.Add(50 * time.Minute)
+		_, err := mp.JoinRoom("room", 2, "10.0.0.2")
+		assert.NoError(t, err)
+
+		*clock = start.Add(time.Hour)
+		assert.Equal(t, 0, mp.ReapRooms(t.Context()), "the join has kept the room alive")
+
+		*clock = start.Add(110 * time.Minute)
+		assert.Equal(t, 1, mp.ReapRooms(t.Context()))
+	})
+
+	t.Run("disabled checks", func(t *testing.T) {
+		mp, clock, _ := setup(t)
+		mp.Reaper = RoomReaperPolicy{Interval: time.Second}
+		mp.DeleteUserSession(1)
+
+		*clock = start.Add(24 * time.Hour)
+		assert.Equal(t, 0, mp.ReapRooms(t.Context()))
+		assert.Len(t, mp.Rooms, 1)
+	})
+}
@@ -0,0 +1,105 @@
+package console
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+)
+
+var (
+	ErrInvalidRoomState = errors.New("invalid game room state transition")
+	ErrRoomNotJoinable  = errors.New("room cannot be joined")
+	ErrNotRoomHost      = errors.New("user is not the host of the room")
+)
+
+// roomTransitions lists the states each state of the game room can move to.
+// The room is created in the creating state, opened once the host is ready and
+// closed before it is destroyed.
+var roomTransitions = map[v1.GameState][]v1.GameState{
+	v1.GameState_GameStateCreating:   {v1.GameState_GameStateOpen, v1.GameState_GameStateClosing},
+	v1.GameState_GameStateOpen:       {v1.GameState_GameStateInProgress, v1.GameState_GameStateClosing},
+	v1.GameState_GameStateInProgress: {v1.GameState_GameStateOpen, v1.GameState_GameStateClosing},
+	v1.GameState_GameStateClosing:    {v1.GameState_GameStateClosed},
+}
+
+// Joinable reports whether the players can join the room.
+func (room *GameRoom) Joinable() bool {
+	return room.State == v1.GameState_GameStateOpen
+}
+
+// RoomStateChange is emitted when the game room moves to another state.
+type RoomStateChange struct {
+	// Room is the state of the room after the transition.
+	Room GameRoom
+	From v1.GameState
+	To   v1.GameState
+	At   time.Time
+}
+
+// OnRoomStateChange registers the listener called on every transition of the
+// game rooms. The listener is called with the rooms locked, so it must not
+// block nor call the methods of the Multiplayer managing the rooms.
+func (mp *Multiplayer) OnRoomStateChange(listener func(RoomStateChange)) {
+	mp.listenersMutex.Lock()
+	defer mp.listenersMutex.Unlock()
+	mp.roomListeners = append(mp.roomListeners, listener)
+}
+
+// transitionRoom moves the room to the state and notifies the listeners. It
+// must be called with the rooms mutex held. Moving to the current state does
+// nothing.
+func (mp *Multiplayer) transitionRoom(room *GameRoom, to v1.GameState) error {
+	from := room.State
+	if from == to {
+		return nil
+	}
+	if !slices.Contains(roomTransitions[from], to) {
+		return fmt.Errorf("%w: %s from %s to %s", ErrInvalidRoomState, room.ID, from, to)
+	}
+	room.State = to
+
+	change := RoomStateChange{Room: room.snapshot(), From: from, To: to, At: mp.now()}
+
+	mp.listenersMutex.Lock()
+	listeners := slices.Clone(mp.roomListeners)
+	mp.listenersMutex.Unlock()
+	for _, listener := range listeners {
+		listener(change)
+	}
+	return nil
+}
+
+// SetRoomState lets the host start the game, so nobody else can join it, or
+// open it again.
+//
+// The game in progress is reached only manually, through the SetGameState
+// RPC. The backend does not know yet which packet tells that the host has
+// started the game.
+func (mp *Multiplayer) SetRoomState(roomId string, hostUserID int64, state v1.GameState) (GameRoom, error) {
+	if state != v1.GameState_GameStateOpen && state != v1.GameState_GameStateInProgress {
+		return GameRoom{}, fmt.Errorf("%w: the game can be only opened or started", ErrInvalidRoomState)
+	}
+
+	mp.roomsMutex.Lock()
+	defer mp.roomsMutex.Unlock()
+
+	room, found := mp.Rooms[roomId]
+	if !found {
+		return GameRoom{}, fmt.Errorf("%w: %s", ErrRoomNotFound, roomId)
+	}
+	if room.HostPlayer == nil || room.HostPlayer.UserID != hostUserID {
+		return GameRoom{}, fmt.Errorf("%w: user %d, room %s", ErrNotRoomHost, hostUserID, roomId)
+	}
+	if err := mp.transitionRoom(room, state); err != nil {
+		return GameRoom{}, err
+	}
+
+	slog.Info("Game room state changed", "gameId", roomId, "state", state)
+	room.ActiveAt = mp.now()
+	mp.persistRoom(room)
+	return room.snapshot(), nil
+}
@@ -0,0 +1,205 @@
+package console
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiplayer_RoomState(t *testing.T) {
+	setup := func(t *testing.T) (*Multiplayer, *[]RoomStateChange) {
+		t.Helper()
+		mp := NewMultiplayer()
+		for id, username := range map[int64]string{1: "archer", 2: "mage"} {
+			session := NewUserSession(id, &recordingConn{})
+			session.User = wire.User{UserID: id, Username: username}
+			mp.AddUserSession(id, session)
+		}
+
+		var changes []RoomStateChange
+		mp.OnRoomStateChange(func(change RoomStateChange) {
+			changes = append(changes, change)
+		})
+		if _, err := mp.CreateRoom(1, "room", "", v1.GameMap_AbandonedRealm, "10.0.0.1", 0); err != nil {
+			t.Fatal(err)
+		}
+		return mp, &changes
+	}
+
+	t.Run("lifecycle", func(t *testing.T) {
+		mp, changes := setup(t)
+
+		room, _ := mp.GetRoom("room")
+		assert.Equal(t, v1.GameState_GameStateCreating, room.State)
+		_, err := mp.JoinRoom("room", 2, "10.0.0.2")
+		assert.ErrorIs(t, err, ErrRoomNotJoinable)
+
+		openRoom(t, mp, "room")
+		_, err = mp.JoinRoom("room", 2, "10.0.0.2")
+		assert.NoError(t, err)
+
+		_, err = mp.SetRoomState("room", 1, v1.GameState_GameStateInProgress)
+		assert.NoError(t, err)
+		mp.DestroyRoom("room")
+
+		var steps [][2]v1.GameState
+		for _, change := range *changes {
+			assert.Equal(t, "room", change.Room.ID)
+			steps = append(steps, [2]v1.GameState{change.From, change.To})
+		}
+		assert.Equal(t, [][2]v1.GameState{
+			{v1.GameState_GameStateCreating, v1.GameState_GameStateOpen},
+			{v1.GameState_GameStateOpen, v1.GameState_GameStateInProgress},
+			{v1.GameState_GameStateInProgress, v1.GameState_GameStateClosing},
+			{v1.GameState_GameStateClosing, v1.GameState_GameStateClosed},
+		}, steps)
+		assert.Len(t, (*changes)[1].Room.Players, 2)
+	})
+
+	t.Run("only the host opens the room", func(t *testing.T) {
+		mp, changes := setup(t)
+		guest, _ := mp.GetUserSession(2)
+
+		assert.ErrorIs(t, mp.SetRoomReady(guest, "room"), ErrNotRoomHost)
+		assert.ErrorIs(t, mp.SetRoomReady(guest, "unknown"), ErrRoomNotFound)
+		room, _ := mp.GetRoom("room")
+		assert.Equal(t, v1.GameState_GameStateCreating, room.State)
+		assert.Empty(t, *changes)
+
+		host, _ := mp.GetUserSession(1)
+		assert.NoError(t, mp.SetRoomReady(host, "room"))
+		room, _ = mp.GetRoom("room")
+		assert.Equal(t, v1.GameState_GameStateOpen, room.State)
+	})
+
+	t.Run("returned rooms do not share the players", func(t *testing.T) {
+		mp, _ := setup(t)
+		openRoom(t, mp, "room")
+
+		joined, err := mp.JoinRoom("room", 2, "10.0.0.2")
+		assert.NoError(t, err)
+		started, err := mp.SetRoomState("room", 1, v1.GameState_GameStateInProgress)
+		assert.NoError(t, err)
+
+		delete(joined.Players, 1)
+		delete(started.Players, 2)
+		room, _ := mp.GetRoom("room")
+		assert.Len(t, room.Players, 2)
+	})
+
+	t.Run("started game cannot be joined", func(t *testing.T) {
+		mp, _ := setup(t)
+		openRoom(t, mp, "room")
+
+		room, err := mp.SetRoomState("room", 1, v1.GameState_GameStateInProgress)
+		assert.NoError(t, err)
+		assert.Equal(t, v1.GameState_GameStateInProgress, room.State)
+
+		_, err = mp.JoinRoom("room", 2, "10.0.0.2")
+		assert.ErrorIs(t, err, ErrRoomNotJoinable)
+
+		_, err = mp.SetRoomState("room", 1, v1.GameState_GameStateOpen)
+		assert.NoError(t, err)
+		_, err = mp.JoinRoom("room", 2, "10.0.0.2")
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid transitions", func(t *testing.T) {
+		mp, changes := setup(t)
+
+		_, err := mp.SetRoomState("room", 1, v1.GameState_GameStateInProgress)
+		assert.ErrorIs(t, err, ErrInvalidRoomState, "the room has not been opened")
+		_, err = mp.SetRoomState("room", 1, v1.GameState_GameStateClosed)
+		assert.ErrorIs(t, err, ErrInvalidRoomState)
+		_, err = mp.SetRoomState("room", 2, v1.GameState_GameStateOpen)
+		assert.ErrorIs(t, err, ErrNotRoomHost)
+		_, err = mp.SetRoomState("missing", 1, v1.GameState_GameStateOpen)
+		assert.ErrorIs(t, err, ErrRoomNotFound)
+
+		room, _ := mp.GetRoom("room")
+		assert.Equal(t, v1.GameState_GameStateCreating, room.State)
+		assert.Empty(t, *changes)
+	})
+}
+
+func TestGameServiceServer_SetGameState(t *testing.T) {
+	g := &gameServiceServer{Multiplayer: NewMultiplayer()}
+	g.Multiplayer.AddUserSession(10, NewUserSession(10, &mockConn{}))
+	g.Multiplayer.AddUserSession(5, NewUserSession(5, &mockConn{}))
+
+	if _, err := g.CreateGame(t.Context(), connect.NewRequest(&v1.CreateGameRequest{
+		GameName:      "room",
+		MapId:         v1.GameMap_FrozenLabyrinth,
+		HostIpAddress: "192.168.100.1",
+		HostUserId:    10,
+	})); err != nil {
+		t.Fatal(err)
+	}
+	listed := func() int {
+		resp, err := g.ListGames(t.Context(), connect.NewRequest(&v1.ListGamesRequest{}))
+		assert.NoError(t, err)
+		return len(resp.Msg.GetGames())
+	}
+	assert.Equal(t, 0, listed(), "the room is still being created")
+
+	_, err := g.SetGameState(t.Context(), connect.NewRequest(&v1.SetGameStateRequest{
+		GameRoomId: "room",
+		HostUserId: 5,
+		State:      v1.GameState_GameStateOpen,
+	}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = g.SetGameState(t.Context(), connect.NewRequest(&v1.SetGameStateRequest{
+		GameRoomId: "room",
+		HostUserId: 10,
+		State:      v1.GameState_GameStateClosed,
+	}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	resp, err := g.SetGameState(t.Context(), connect.NewRequest(&v1.SetGameStateRequest{
+		GameRoomId: "room",
+		HostUserId: 10,
+		State:      v1.GameState_GameStateOpen,
+	}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, v1.GameState_GameStateOpen, resp.Msg.Game.State)
+	}
+	assert.Equal(t, 1, listed())
+
+	_, err = g.SetGameState(t.Context(), connect.NewRequest(&v1.SetGameStateRequest{
+		GameRoomId: "room",
+		HostUserId: 10,
+		State:      v1.GameState_GameStateInProgress,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, listed(), "the game has started")
+
+	_, err = g.JoinGame(t.Context(), connect.NewRequest(&v1.JoinGameRequest{
+		GameRoomId: "room",
+		UserId:     5,
+		IpAddress:  "192.168.100.2",
+	}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = g.SetGameState(t.Context(), connect.NewRequest(&v1.SetGameStateRequest{
+		GameRoomId: "missing",
+		HostUserId: 10,
+		State:      v1.GameState_GameStateOpen,
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+// openRoom opens the room on behalf of its host.
+func openRoom(t *testing.T, mp *Multiplayer, roomID string) {
+	t.Helper()
+	room, ok := mp.GetRoom(roomID)
+	if !ok {
+		t.Fatalf("room %s not found", roomID)
+	}
+	if err := mp.SetRoomReady(room.HostPlayer, roomID); err != nil {
+		t.Fatal(err)
+	}
+}
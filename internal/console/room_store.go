@@ -0,0 +1,296 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/app/logger/logging"
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
+)
+
+// RoomStore writes the game rooms and their players through to the database,
+// so the games survive the restart of the console.
+type RoomStore struct {
+	DB *database.SQLite
+
+	now func() time.Time
+}
+
+func NewRoomStore(db *database.SQLite, now func() time.Time) *RoomStore {
+	return &RoomStore{DB: db, now: now}
+}
+
+// Save replaces the stored room and its players with the current state of the
+// room. Nothing is stored when the store is nil.
+func (s *RoomStore) Save(ctx context.Context, room *GameRoom) error {
+	if s == nil {
+		return nil
+	}
+
+	tx, queries, err := s.DB.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var hostUserID, createdBy int64
+	if room.HostPlayer != nil {
+		hostUserID = room.HostPlayer.UserID
+	}
+	if room.CreatedBy != nil {
+		createdBy = room.CreatedBy.UserID
+	}
+	createdAt := room.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = s.now()
+	}
+	if err := queries.UpsertGameRoom(ctx, database.UpsertGameRoomParams{
+		ID:           room.ID,
+		Name:         room.Name,
+		PasswordHash: room.PasswordHash,
+		MapID:        int64(room.MapID),
+		HostUserID:   hostUserID,
+		CreatedBy:    createdBy,
+		CreatedAt:    createdAt.Unix(),
+		MaxPlayers:   int64(room.MaxPlayers),
+		State:        int64(room.State),
+	}); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err := queries.DeleteGameRoomPlayers(ctx, room.ID); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	for _, player := range room.Players {
+		if err := queries.CreateGameRoomPlayer(ctx, database.CreateGameRoomPlayerParams{
+			GameRoomID:  room.ID,
+			UserID:      player.UserID,
+			Username:    player.User.Username,
+			CharacterID: player.Character.CharacterID,
+			ClassType:   int64(player.Character.ClassType),
+			IpAddress:   player.IPAddress,
+			JoinedAt:    player.JoinedAt.Unix(),
+		}); err != nil {
+			return errors.Join(err, tx.Rollback())
+		}
+	}
+	return tx.Commit()
+}
+
+// Delete removes the room and its players from the database.
+func (s *RoomStore) Delete(ctx context.Context, roomID string) error {
+	if s == nil {
+		return nil
+	}
+
+	tx, queries, err := s.DB.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := queries.DeleteGameRoomPlayers(ctx, roomID); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if err := queries.DeleteGameRoom(ctx, roomID); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+// Load returns the stored rooms. The players are detached sessions, which
+// are replaced with the real ones once the players connect to the lobby. The
+// player who joined first becomes the host, when the stored host is not in
+// the room anymore, and the rooms without any player are skipped.
+func (s *RoomStore) Load(ctx context.Context) ([]*GameRoom, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	rooms, err := s.DB.Read.ListGameRooms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	players, err := s.DB.Read.ListGameRoomPlayers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]map[int64]*UserSession, len(rooms))
+	for _, player := range players {
+		if members[player.GameRoomID] == nil {
+			members[player.GameRoomID] = make(map[int64]*UserSession)
+		}
+		members[player.GameRoomID][player.UserID] = &UserSession{
+			UserID:    player.UserID,
+			GameID:    player.GameRoomID,
+			IPAddress: player.IpAddress,
+			JoinedAt:  time.Unix(player.JoinedAt, 0).In(time.UTC),
+			User:      wire.User{UserID: player.UserID, Username: player.Username},
+			Character: wire.Character{CharacterID: player.CharacterID, ClassType: byte(player.ClassType)},
+		}
+	}
+
+	list := make([]*GameRoom, 0, len(rooms))
+	for _, stored := range rooms {
+		room := &GameRoom{
+			ID:           stored.ID,
+			Name:         stored.Name,
+			PasswordHash: stored.PasswordHash,
+			MapID:        v1.GameMap(stored.MapID),
+			State:        v1.GameState(stored.State),
+			MaxPlayers:   int(stored.MaxPlayers),
+			Players:      members[stored.ID],
+			CreatedAt:    time.Unix(stored.CreatedAt, 0),
+			ActiveAt:     s.now(),
+			restored:     true,
+		}
+		if len(room.Players) == 0 {
+			// The room is left without any player, there is nobody to
+			// host it.
+			slog.Warn("Skipped the stored game room without players", "gameId", stored.ID)
+			continue
+		}
+		room.HostPlayer = room.Players[stored.HostUserID]
+		if room.HostPlayer == nil {
+			room.HostPlayer = room.earliestPlayer()
+		}
+		room.CreatedBy = room.Players[stored.CreatedBy]
+		if room.CreatedBy == nil {
+			room.CreatedBy = &UserSession{UserID: stored.CreatedBy}
+		}
+		list = append(list, room)
+	}
+	return list, nil
+}
+
+// persistRoom stores the current state of the room. It must be called with
+// the rooms mutex held. The failures are only logged.
+func (mp *Multiplayer) persistRoom(room *GameRoom) {
+	if mp.RoomStore == nil || mp.stopping.Load() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := mp.RoomStore.Save(ctx, room); err != nil {
+		slog.Warn("Could not store the game room", "gameId", room.ID, logging.Error(err))
+	}
+}
+
+// forgetRoom removes the room from the database. The rooms are kept, when the
+// console is stopping, so they are restored on the next start.
+func (mp *Multiplayer) forgetRoom(roomID string) {
+	if mp.RoomStore == nil || mp.stopping.Load() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := mp.RoomStore.Delete(ctx, roomID); err != nil {
+		slog.Warn("Could not delete the stored game room", "gameId", roomID, logging.Error(err))
+	}
+}
+
+// RestoreRooms loads the rooms stored before the console has been restarted.
+// It returns the number of restored rooms.
+func (mp *Multiplayer) RestoreRooms(ctx context.Context) (int, error) {
+	rooms, err := mp.RoomStore.Load(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	mp.roomsMutex.Lock()
+	defer mp.roomsMutex.Unlock()
+
+	restored := 0
+	for _, room := range rooms {
+		if _, exists := mp.Rooms[room.ID]; exists {
+			continue
+		}
+		mp.Rooms[room.ID] = room
+		restored++
+	}
+	return restored, nil
+}
+
+// adoptRoomMembership puts the session of the reconnected user in place of the
+// detached one in the restored game room.
+func (mp *Multiplayer) adoptRoomMembership(session *UserSession) {
+	mp.roomsMutex.Lock()
+	defer mp.roomsMutex.Unlock()
+
+	for _, room := range mp.Rooms {
+		detached, ok := room.Players[session.UserID]
+		if !ok || detached == session {
+			continue
+		}
+
+		session.GameID = room.ID
+		session.IPAddress = detached.IPAddress
+		session.JoinedAt = detached.JoinedAt
+		if session.Character.CharacterID == 0 {
+			session.Character = detached.Character
+		}
+
+		room.Players[session.UserID] = session
+		if room.HostPlayer == detached {
+			room.HostPlayer = session
+		}
+		if room.CreatedBy != nil && room.CreatedBy.UserID == session.UserID {
+			room.CreatedBy = session
+		}
+		slog.Info("Player has returned to the restored game room", "gameId", room.ID, "userId", session.UserID)
+		return
+	}
+}
+
+// ReconcileRooms drops the restored rooms, which hosts have not reconnected,
+// and removes the players, who have not returned, from the other rooms.
+func (mp *Multiplayer) ReconcileRooms() {
+	mp.roomsMutex.Lock()
+	defer mp.roomsMutex.Unlock()
+
+	for id, room := range mp.Rooms {
+		if room.HostPlayer == nil || mp.isDetached(room.HostPlayer) {
+			slog.Info("Dropping the game room, the host has not reconnected", "gameId", id)
+			for _, player := range room.Players {
+				if !mp.isDetached(player) {
+					player.GameID = ""
+				}
+			}
+			mp.DestroyRoom(id)
+			continue
+		}
+
+		room.restored = false
+		changed := false
+		for userID, player := range room.Players {
+			if mp.isDetached(player) {
+				delete(room.Players, userID)
+				changed = true
+			}
+		}
+		if changed {
+			mp.persistRoom(room)
+		}
+	}
+}
+
+// reconcileRoomsAfter waits for the players of the restored rooms to
+// reconnect, before reconciling the rooms.
+func (mp *Multiplayer) reconcileRoomsAfter(ctx context.Context, grace time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(grace):
+		mp.ReconcileRooms()
+	}
+}
+
+// isDetached reports whether the session has been restored from the database
+// and the user has not connected to the lobby since.
+func (mp *Multiplayer) isDetached(session *UserSession) bool {
+	current, ok := mp.GetUserSession(session.UserID)
+	return !ok || current != session
+}
@@ -0,0 +1,166 @@
+package console
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiplayer_RoomStore(t *testing.T) {
+	newMultiplayer := func(t *testing.T, store *RoomStore) *Multiplayer {
+		t.Helper()
+		mp := NewMultiplayer()
+		mp.RoomStore = store
+		return mp
+	}
+	connect := func(mp *Multiplayer, userID int64, username string) *UserSession {
+		session := NewUserSession(userID, &recordingConn{})
+		session.User = wire.User{UserID: userID, Username: username}
+		session.Character = wire.Character{CharacterID: userID * 10, ClassType: 1}
+		mp.SetPlayerConnected(session)
+		return session
+	}
+
+	t.Run("rooms are written through to the database", func(t *testing.T) {
+		store := NewRoomStore(setupDatabase(t), time.Now)
+		mp := newMultiplayer(t, store)
+		archer := connect(mp, 1, "archer")
+		mage := connect(mp, 2, "mage")
+
+		_, err := mp.CreateRoom(archer.UserID, "room", "secret", v1.GameMap_AbandonedRealm, "10.0.0.1", 0)
+		assert.NoError(t, err)
+		openRoom(t, mp, "room")
+		_, err = mp.JoinRoom("room", mage.UserID, "10.0.0.2")
+		assert.NoError(t, err)
+
+		rooms, err := store.Load(t.Context())
+		assert.NoError(t, err)
+		if assert.Len(t, rooms, 1) {
+			assert.Equal(t, "room", rooms[0].ID)
+			assert.True(t, rooms[0].CheckPassword("secret"))
+			assert.Equal(t, v1.GameMap_AbandonedRealm, rooms[0].MapID)
+			assert.Equal(t, v1.GameState_GameStateOpen, rooms[0].State)
+			assert.Equal(t, int64(1), rooms[0].HostPlayer.UserID)
+			assert.Equal(t, "10.0.0.2", rooms[0].Players[2].IPAddress)
+			assert.Equal(t, "mage", rooms[0].Players[2].User.Username)
+			assert.Equal(t, int64(20), rooms[0].Players[2].Character.CharacterID)
+		}
+
+		mp.LeaveRoom(t.Context(), archer)
+		rooms, err = store.Load(t.Context())
+		assert.NoError(t, err)
+		if assert.Len(t, rooms, 1) {
+			assert.Equal(t, int64(2), rooms[0].HostPlayer.UserID, "host has migrated")
+			assert.Len(t, rooms[0].Players, 1)
+		}
+
+		mp.LeaveRoom(t.Context(), mage)
+		rooms, err = store.Load(t.Context())
+		assert.NoError(t, err)
+		assert.Empty(t, rooms)
+	})
+
+	t.Run("rooms are kept when the console stops", func(t *testing.T) {
+		store := NewRoomStore(setupDatabase(t), time.Now)
+		mp := newMultiplayer(t, store)
+		archer := connect(mp, 1, "archer")
+		_, err := mp.CreateRoom(archer.UserID, "room", "", v1.GameMap_AbandonedRealm, "10.0.0.1", 0)
+		assert.NoError(t, err)
+
+		mp.stopping.Store(true)
+		mp.SetPlayerDisconnected(archer)
+
+		rooms, err := store.Load(t.Context())
+		assert.NoError(t, err)
+		assert.Len(t, rooms, 1)
+	})
+
+	t.Run("restored rooms are adopted and reconciled", func(t *testing.T) {
+		store := NewRoomStore(setupDatabase(t), time.Now)
+		before := newMultiplayer(t, store)
+		for id, username := range map[int64]string{1: "archer", 2: "mage", 3: "knight", 4: "rogue"} {
+			connect(before, id, username)
+		}
+		_, err := before.CreateRoom(1, "kept", "", v1.GameMap_AbandonedRealm, "10.0.0.1", 0)
+		assert.NoError(t, err)
+		openRoom(t, before, "kept")
+		_, err = before.JoinRoom("kept", 2, "10.0.0.2")
+		assert.NoError(t, err)
+		_, err = before.CreateRoom(3, "dropped", "", v1.GameMap_AbandonedRealm, "10.0.0.3", 0)
+		assert.NoError(t, err)
+		openRoom(t, before, "dropped")
+		_, err = before.JoinRoom("dropped", 4, "10.0.0.4")
+		assert.NoError(t, err)
+
+		mp := newMultiplayer(t, store)
+		restored, err := mp.RestoreRooms(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, restored)
+
+		// The host of the "kept" room and a player of the "dropped" one
+		// reconnect to the lobby.
+		archer := connect(mp, 1, "archer")
+		rogue := connect(mp, 4, "rogue")
+		assert.Equal(t, "kept", archer.GameID)
+		assert.Equal(t, "10.0.0.1", archer.IPAddress)
+		assert.Equal(t, "dropped", rogue.GameID)
+		assert.NoError(t, mp.AuthorizeRoomMember("kept", archer.UserID))
+
+		mp.ReconcileRooms()
+
+		room, ok := mp.GetRoom("kept")
+		if assert.True(t, ok) {
+			assert.Same(t, archer, room.HostPlayer)
+			assert.Equal(t, map[int64]*UserSession{1: archer}, room.Players)
+		}
+		_, ok = mp.GetRoom("dropped")
+		assert.False(t, ok)
+		assert.Empty(t, rogue.GameID)
+
+		rooms, err := store.Load(t.Context())
+		assert.NoError(t, err)
+		if assert.Len(t, rooms, 1) {
+			assert.Equal(t, "kept", rooms[0].ID)
+			assert.Len(t, rooms[0].Players, 1)
+		}
+	})
+
+	t.Run("restored room without the host elects a new one", func(t *testing.T) {
+		store := NewRoomStore(setupDatabase(t), time.Now)
+		joinedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		player := func(userID int64, joined time.Duration) *UserSession {
+			return &UserSession{UserID: userID, JoinedAt: joinedAt.Add(joined), User: wire.User{UserID: userID}}
+		}
+		assert.NoError(t, store.Save(t.Context(), &GameRoom{
+			ID:         "orphaned",
+			Name:       "orphaned",
+			HostPlayer: player(1, 0),
+			Players:    map[int64]*UserSession{2: player(2, 2*time.Minute), 3: player(3, time.Minute)},
+		}))
+		assert.NoError(t, store.Save(t.Context(), &GameRoom{
+			ID:         "empty",
+			Name:       "empty",
+			HostPlayer: player(4, 0),
+		}))
+
+		mp := newMultiplayer(t, store)
+		restored, err := mp.RestoreRooms(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, restored, "the room without players is skipped")
+
+		room, ok := mp.GetRoom("orphaned")
+		if assert.True(t, ok) && assert.NotNil(t, room.HostPlayer) {
+			assert.Equal(t, int64(3), room.HostPlayer.UserID, "the earliest player becomes the host")
+		}
+
+		knight := connect(mp, 3, "knight")
+		mp.LeaveRoom(t.Context(), knight)
+		room, _ = mp.GetRoom("orphaned")
+		if assert.NotNil(t, room.HostPlayer) {
+			assert.Equal(t, int64(2), room.HostPlayer.UserID)
+		}
+	})
+}
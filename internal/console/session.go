@@ -14,6 +14,7 @@ import (
 type UserSession struct {
 	UserID    int64  `json:"userID,omitempty"`
 	GameID    string `json:"gameID,omitempty"`
+	Channel   string `json:"channel,omitempty"`
 	Connected bool   `json:"connected,omitempty"`
 
 	ConnectedAt time.Time `json:"connectedAt,omitempty"`
@@ -22,6 +23,9 @@ type UserSession struct {
 	// TODO: It is never provided
 	IPAddress string `json:"ip"`
 
+	// RemoteIP is the address the lobby connection has been made from.
+	RemoteIP string `json:"remoteIP,omitempty"`
+
 	wsConn ConnReadWriter
 
 	User      wire.User
@@ -71,6 +75,12 @@ func (us *UserSession) SendMessage(ctx context.Context, msgType wire.EventType,
 	us.Send(ctx, wire.Compose(msgType, msg))
 }
 
+// HasRole reports whether the user is allowed to perform the actions
+// restricted to the given role, e.g. the moderator commands in the lobby.
+func (us *UserSession) HasRole(role wire.Role) bool {
+	return us.User.Role.Includes(role)
+}
+
 func (us *UserSession) ToPlayer() wire.Player {
 	return wire.Player{
 		UserID:      us.User.UserID,
@@ -85,6 +95,6 @@ var _ ConnReadWriter = (*websocket.Conn)(nil)
 type ConnReadWriter interface {
 	Read(ctx context.Context) (websocket.MessageType, []byte, error)
 	Write(ctx context.Context, typ websocket.MessageType, p []byte) error
+	Close(code websocket.StatusCode, reason string) error
 	CloseNow() error
-	// TODO: Add Close function
 }
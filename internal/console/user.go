@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
@@ -12,12 +13,23 @@ import (
 	"github.com/dimspell/gladiator/internal/app/logger/logging"
 	"github.com/dimspell/gladiator/internal/console/auth"
 	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/metrics"
+	"github.com/dimspell/gladiator/internal/wire"
 )
 
 var _ multiv1connect.UserServiceHandler = (*userServiceServer)(nil)
 
 type userServiceServer struct {
-	DB *database.SQLite
+	DB       *database.SQLite
+	Sessions *auth.SessionSigner
+	Throttle *loginThrottle
+
+	PasswordCost     int
+	PasswordResetTTL time.Duration
+
+	Bans  *BanList
+	Roles *roleAuthorizer
+	Names *NamePolicy
 }
 
 // CreateUser creates a new user.
@@ -26,7 +38,14 @@ func (s *userServiceServer) CreateUser(ctx context.Context, req *connect.Request
 		return nil, err
 	}
 
-	password, err := auth.NewPassword(req.Msg.Password)
+	if err := s.checkBan(ctx, 0, remoteIP(req.Peer().Addr)); err != nil {
+		return nil, err
+	}
+	if err := s.Names.Validate(req.Msg.Username); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid username: %w", err))
+	}
+
+	password, err := auth.NewPasswordWithCost(req.Msg.Password, s.PasswordCost)
 	if err != nil {
 		slog.Warn("could not hash the password", logging.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -48,12 +67,20 @@ func (s *userServiceServer) CreateUser(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeAborted, err)
 	}
 
+	token, err := s.Sessions.Issue(user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	resp := connect.NewResponse(&multiv1.CreateUserResponse{
 		User: &multiv1.User{
 			UserId:   user.ID,
 			Username: user.Username,
-		}},
-	)
+			Role:     roleToProto(wire.Role(user.Role)),
+		},
+		SessionToken: token,
+		RelayKey:     s.Sessions.RelayKey(token),
+	})
 	return resp, nil
 }
 
@@ -63,25 +90,31 @@ func (s *userServiceServer) AuthenticateUser(ctx context.Context, req *connect.R
 		return nil, err
 	}
 
-	user, err := s.DB.Read.GetUserByName(ctx, req.Msg.Username)
+	user, err := s.checkCredentials(ctx, req.Msg.Username, req.Msg.Password, remoteIP(req.Peer().Addr), false)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("incorrect password or username"))
-		// slog.Debug("packet-41: could not find a user", "username", data.Username)
-		// return session.SendToGame(ClientAuthentication, []byte{0, 0, 0, 0})
+		return nil, err
 	}
 
-	if !auth.CheckPassword(req.Msg.Password, user.Password) {
-		// slog.Debug("packet-41: incorrect password")
-		// return session.SendToGame(ClientAuthentication, []byte{0, 0, 0, 0})
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("incorrect password or username"))
+	if auth.NeedsRehash(user.Password, s.PasswordCost) {
+		if err := s.updatePassword(ctx, user.ID, req.Msg.Password); err != nil {
+			slog.Warn("could not re-hash the password", "userId", user.ID, logging.Error(err))
+		}
+	}
+
+	token, err := s.Sessions.Issue(user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	resp := connect.NewResponse(&multiv1.AuthenticateUserResponse{
 		User: &multiv1.User{
 			UserId:   user.ID,
 			Username: user.Username,
-		}},
-	)
+			Role:     roleToProto(wire.Role(user.Role)),
+		},
+		SessionToken: token,
+		RelayKey:     s.Sessions.RelayKey(token),
+	})
 	return resp, nil
 }
 
@@ -96,7 +129,157 @@ func (s *userServiceServer) GetUser(ctx context.Context, req *connect.Request[mu
 		User: &multiv1.User{
 			UserId:   user.ID,
 			Username: user.Username,
+			Role:     roleToProto(wire.Role(user.Role)),
 		}},
 	)
 	return resp, nil
 }
+
+// ChangePassword changes the password of a user. The request must carry
+// either the current password or the one-time code issued by ResetPassword.
+func (s *userServiceServer) ChangePassword(ctx context.Context, req *connect.Request[multiv1.ChangePasswordRequest]) (*connect.Response[multiv1.ChangePasswordResponse], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if req.Msg.NewPassword == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("new password cannot be empty"))
+	}
+
+	user, err := s.checkCredentials(ctx, req.Msg.Username, req.Msg.OldPassword, remoteIP(req.Peer().Addr), true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.updatePassword(ctx, user.ID, req.Msg.NewPassword); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.Info("Password changed", "userId", user.ID)
+	return connect.NewResponse(&multiv1.ChangePasswordResponse{}), nil
+}
+
+// ResetPassword issues a one-time code, which can be used instead of the
+// forgotten password to set a new one. It is available only to the admins.
+func (s *userServiceServer) ResetPassword(ctx context.Context, req *connect.Request[multiv1.ResetPasswordRequest]) (*connect.Response[multiv1.ResetPasswordResponse], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if _, err := s.Roles.Require(ctx, req.Header(), wire.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, err := s.DB.Read.GetUserByName(ctx, req.Msg.Username)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	code, hash, err := auth.NewResetCode()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	expiresAt := time.Now().Add(s.PasswordResetTTL).Unix()
+	if err := s.DB.Write.UpsertPasswordReset(ctx, database.UpsertPasswordResetParams{
+		UserID:    user.ID,
+		CodeHash:  hash,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.Info("Issued password reset code", "userId", user.ID)
+	return connect.NewResponse(&multiv1.ResetPasswordResponse{
+		ResetCode: code,
+		ExpiresAt: expiresAt,
+	}), nil
+}
+
+// checkCredentials verifies the password of the user, taking into account the
+// lockout of the repeated failures. When allowResetCode is set, a valid
+// one-time reset code is accepted in place of the password.
+func (s *userServiceServer) checkCredentials(ctx context.Context, username, password, ip string, allowResetCode bool) (database.User, error) {
+	// Check the lockout before touching the password, so the bcrypt cost
+	// cannot be used to exhaust the CPU.
+	wait, err := s.Throttle.LockedFor(ctx, username, ip)
+	if err != nil {
+		return database.User{}, connect.NewError(connect.CodeInternal, err)
+	}
+	if wait > 0 {
+		metrics.LoginFailures.WithLabelValues("locked").Inc()
+		return database.User{}, connect.NewError(connect.CodeResourceExhausted, errTooManyAttempts(wait))
+	}
+
+	if err := s.checkBan(ctx, 0, ip); err != nil {
+		return database.User{}, err
+	}
+
+	user, err := s.DB.Read.GetUserByName(ctx, username)
+	if err != nil {
+		metrics.LoginFailures.WithLabelValues("username").Inc()
+		if err := s.Throttle.Fail(ctx, "", ip); err != nil {
+			slog.Warn("could not record failed sign-in attempt", logging.Error(err))
+		}
+		return database.User{}, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("incorrect password or username"))
+	}
+
+	if !auth.CheckPassword(password, user.Password) && !(allowResetCode && s.checkResetCode(ctx, user.ID, password)) {
+		metrics.LoginFailures.WithLabelValues("password").Inc()
+		if err := s.Throttle.Fail(ctx, user.Username, ip); err != nil {
+			slog.Warn("could not record failed sign-in attempt", logging.Error(err))
+		}
+		return database.User{}, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("incorrect password or username"))
+	}
+
+	if err := s.Throttle.Reset(ctx, user.Username); err != nil {
+		slog.Warn("could not reset failed sign-in attempts", logging.Error(err))
+	}
+
+	// The ban of the user is revealed only to those who know the password.
+	if err := s.checkBan(ctx, user.ID, ip); err != nil {
+		return database.User{}, err
+	}
+	return user, nil
+}
+
+func (s *userServiceServer) checkBan(ctx context.Context, userID int64, ip string) error {
+	if err := s.Bans.Check(ctx, userID, ip); err != nil {
+		var banErr *BanError
+		if errors.As(err, &banErr) {
+			metrics.LoginFailures.WithLabelValues("banned").Inc()
+			return connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+func (s *userServiceServer) checkResetCode(ctx context.Context, userID int64, code string) bool {
+	reset, err := s.DB.Read.GetPasswordReset(ctx, userID)
+	if err != nil {
+		return false
+	}
+	return time.Now().Unix() < reset.ExpiresAt && auth.CheckResetCode(code, reset.CodeHash)
+}
+
+// updatePassword stores the new password hashed with the configured cost and
+// invalidates any pending reset code.
+func (s *userServiceServer) updatePassword(ctx context.Context, userID int64, text string) error {
+	password, err := auth.NewPasswordWithCost(text, s.PasswordCost)
+	if err != nil {
+		return err
+	}
+
+	tx, queries, err := s.DB.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := queries.UpdateUserPassword(ctx, database.UpdateUserPasswordParams{
+		Password: password.String(),
+		ID:       userID,
+	}); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if err := queries.DeletePasswordReset(ctx, userID); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
@@ -1,16 +1,43 @@
 package console
 
 import (
+	"database/sql"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	multiv1 "github.com/dimspell/gladiator/gen/multi/v1"
+	"github.com/dimspell/gladiator/internal/console/auth"
+	"github.com/dimspell/gladiator/internal/console/database"
+	"github.com/dimspell/gladiator/internal/wire"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
 )
 
+func helperNewUserService(t *testing.T) *userServiceServer {
+	t.Helper()
+
+	db := setupDatabase(t)
+	sessions := auth.NewSessionSigner([]byte("secret"), time.Hour, time.Now)
+	return &userServiceServer{
+		DB:               db,
+		Sessions:         sessions,
+		Throttle:         newLoginThrottle(db, time.Now),
+		PasswordCost:     bcrypt.MinCost,
+		PasswordResetTTL: time.Hour,
+		Bans:             NewBanList(db, time.Now),
+		Roles: &roleAuthorizer{
+			AdminSecret: []byte("admin-secret"),
+			Sessions:    sessions,
+			DB:          db,
+		},
+		Names: &DefaultConfig().UsernamePolicy,
+	}
+}
+
 func TestUserServiceHandler(t *testing.T) {
 	t.Run("create user and sign in", func(t *testing.T) {
-		service := &userServiceServer{DB: setupDatabase(t)}
+		service := helperNewUserService(t)
 
 		res, err := service.CreateUser(t.Context(), connect.NewRequest(&multiv1.CreateUserRequest{
 			Username: "testuser",
@@ -40,10 +67,15 @@ func TestUserServiceHandler(t *testing.T) {
 		assert.Equal(t, "testuser", res2.Msg.User.Username)
 		assert.Equal(t, int64(1), res3.Msg.User.UserId)
 		assert.Equal(t, "testuser", res3.Msg.User.Username)
+
+		userID, err := service.Sessions.Verify(res2.Msg.SessionToken)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), userID)
+		assert.NotEmpty(t, res.Msg.SessionToken)
 	})
 
 	t.Run("authentication fails", func(t *testing.T) {
-		service := &userServiceServer{DB: setupDatabase(t)}
+		service := helperNewUserService(t)
 
 		var err error
 		_, err = service.CreateUser(t.Context(), connect.NewRequest(&multiv1.CreateUserRequest{
@@ -70,4 +102,227 @@ func TestUserServiceHandler(t *testing.T) {
 			assert.Error(t, err, "wrong password")
 		}
 	})
+
+	t.Run("locked out after repeated failures", func(t *testing.T) {
+		service := helperNewUserService(t)
+
+		_, err := service.CreateUser(t.Context(), connect.NewRequest(&multiv1.CreateUserRequest{
+			Username: "testuser",
+			Password: "password",
+		}))
+		if err != nil {
+			t.Fatalf("create user failed: %v", err)
+		}
+
+		authenticate := func(password string) error {
+			_, err := service.AuthenticateUser(t.Context(), connect.NewRequest(&multiv1.AuthenticateUserRequest{
+				Username: "testuser",
+				Password: password,
+			}))
+			return err
+		}
+
+		allowed := service.Throttle.Policies[loginScopeUser].Allowed
+		for i := int64(0); i <= allowed; i++ {
+			assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(authenticate("wrongpassword")))
+		}
+
+		// Even the correct password is rejected during the lockout.
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(authenticate("password")))
+
+		service.Throttle.now = func() time.Time { return time.Now().Add(time.Minute) }
+		assert.NoError(t, authenticate("password"))
+
+		_, err = service.DB.Read.GetLoginAttempt(t.Context(), database.GetLoginAttemptParams{Scope: loginScopeUser, Subject: "testuser"})
+		assert.ErrorIs(t, err, sql.ErrNoRows, "failures should be reset after a successful sign-in")
+	})
+
+	t.Run("banned user", func(t *testing.T) {
+		service := helperNewUserService(t)
+
+		user, err := service.CreateUser(t.Context(), connect.NewRequest(&multiv1.CreateUserRequest{
+			Username: "testuser",
+			Password: "password",
+		}))
+		if err != nil {
+			t.Fatalf("create user failed: %v", err)
+		}
+		if _, err := service.Bans.Create(t.Context(), user.Msg.User.UserId, "", "cheating", "admin", 0); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = service.AuthenticateUser(t.Context(), connect.NewRequest(&multiv1.AuthenticateUserRequest{
+			Username: "testuser",
+			Password: "password",
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+		assert.ErrorContains(t, err, "cheating")
+	})
+
+	t.Run("username rejected by the policy", func(t *testing.T) {
+		service := helperNewUserService(t)
+
+		for _, username := range []string{"system", "us\ter", ""} {
+			_, err := service.CreateUser(t.Context(), connect.NewRequest(&multiv1.CreateUserRequest{
+				Username: username,
+				Password: "password",
+			}))
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), username)
+		}
+	})
+
+	t.Run("re-hash password with configured cost", func(t *testing.T) {
+		service := helperNewUserService(t)
+		service.PasswordCost = bcrypt.MinCost + 1
+
+		password, err := auth.NewPasswordWithCost("password", bcrypt.MinCost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user, err := service.DB.Write.CreateUser(t.Context(), database.CreateUserParams{
+			Username: "testuser",
+			Password: password.String(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = service.AuthenticateUser(t.Context(), connect.NewRequest(&multiv1.AuthenticateUserRequest{
+			Username: "testuser",
+			Password: "password",
+		}))
+		assert.NoError(t, err)
+
+		user, err = service.DB.Read.GetUserByID(t.Context(), user.ID)
+		assert.NoError(t, err)
+		assert.False(t, auth.NeedsRehash(user.Password, bcrypt.MinCost+1))
+		assert.True(t, auth.CheckPassword("password", user.Password))
+	})
+}
+
+func TestUserServiceHandler_ChangePassword(t *testing.T) {
+	newService := func(t *testing.T) *userServiceServer {
+		service := helperNewUserService(t)
+		_, err := service.CreateUser(t.Context(), connect.NewRequest(&multiv1.CreateUserRequest{
+			Username: "testuser",
+			Password: "password",
+		}))
+		if err != nil {
+			t.Fatalf("create user failed: %v", err)
+		}
+		return service
+	}
+
+	changePassword := func(service *userServiceServer, oldPassword, newPassword string) error {
+		_, err := service.ChangePassword(t.Context(), connect.NewRequest(&multiv1.ChangePasswordRequest{
+			Username:    "testuser",
+			OldPassword: oldPassword,
+			NewPassword: newPassword,
+		}))
+		return err
+	}
+
+	authenticate := func(service *userServiceServer, password string) error {
+		_, err := service.AuthenticateUser(t.Context(), connect.NewRequest(&multiv1.AuthenticateUserRequest{
+			Username: "testuser",
+			Password: password,
+		}))
+		return err
+	}
+
+	resetPassword := func(service *userServiceServer, adminToken string) (*multiv1.ResetPasswordResponse, error) {
+		req := connect.NewRequest(&multiv1.ResetPasswordRequest{Username: "testuser"})
+		if adminToken != "" {
+			req.Header().Set("Authorization", "Bearer "+adminToken)
+		}
+		resp, err := service.ResetPassword(t.Context(), req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	t.Run("with old password", func(t *testing.T) {
+		service := newService(t)
+
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(changePassword(service, "wrongpassword", "newpassword")))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(changePassword(service, "password", "")))
+		assert.NoError(t, changePassword(service, "password", "newpassword"))
+
+		assert.Error(t, authenticate(service, "password"))
+		assert.NoError(t, authenticate(service, "newpassword"))
+	})
+
+	t.Run("reset requires admin", func(t *testing.T) {
+		service := newService(t)
+
+		_, err := resetPassword(service, "")
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		_, err = resetPassword(service, "wrong")
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		moderator, err := service.DB.Write.CreateUser(t.Context(), database.CreateUserParams{Username: "moderator", Password: "x"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.DB.Write.UpdateUserRole(t.Context(), database.UpdateUserRoleParams{Role: string(wire.RoleModerator), ID: moderator.ID}); err != nil {
+			t.Fatal(err)
+		}
+		token, err := service.Sessions.Issue(moderator.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = resetPassword(service, token)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		if _, err := service.DB.Write.UpdateUserRole(t.Context(), database.UpdateUserRoleParams{Role: string(wire.RoleAdmin), ID: moderator.ID}); err != nil {
+			t.Fatal(err)
+		}
+		_, err = resetPassword(service, token)
+		assert.NoError(t, err, "the user with the admin role can reset passwords")
+
+		service.Roles.AdminSecret = nil
+		_, err = resetPassword(service, "admin-secret")
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("with reset code", func(t *testing.T) {
+		service := newService(t)
+
+		reset, err := resetPassword(service, "admin-secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotEmpty(t, reset.ResetCode)
+		assert.Greater(t, reset.ExpiresAt, time.Now().Unix())
+
+		// The code cannot be used to sign in directly.
+		assert.Error(t, authenticate(service, reset.ResetCode))
+
+		assert.NoError(t, changePassword(service, reset.ResetCode, "newpassword"))
+		assert.NoError(t, authenticate(service, "newpassword"))
+
+		// The code can be used only once.
+		assert.Error(t, changePassword(service, reset.ResetCode, "otherpassword"))
+	})
+
+	t.Run("expired reset code", func(t *testing.T) {
+		service := newService(t)
+
+		reset, err := resetPassword(service, "admin-secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		pending, err := service.DB.Read.GetPasswordReset(t.Context(), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pending.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+		if err := service.DB.Write.UpsertPasswordReset(t.Context(), database.UpsertPasswordResetParams(pending)); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Error(t, changePassword(service, reset.ResetCode, "newpassword"))
+	})
 }
@@ -1,38 +1,49 @@
 package console
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/tls"
 	_ "embed"
+	"encoding/json"
 )
 
-var hmacKey = []byte("shared-secret-key")
-
-func sign(data []byte) []byte {
-	//mac := hmac.New(sha256.New, hmacKey)
-	//mac.Write(data)
-	//return append(mac.Sum(nil), data...)
-	return data
+// sign computes the HMAC-SHA256 signature of the relay packet using the
+// per-session relay key and stores it in the packet.
+func sign(key []byte, pkt *RelayPacket) error {
+	pkt.Signature = nil
+	data, err := json.Marshal(pkt)
+	if err != nil {
+		return err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	pkt.Signature = mac.Sum(nil)
+	return nil
 }
 
-func verify(packet []byte) ([]byte, bool) {
-	return packet, true
-	//if len(packet) < 32 {
-	//	return nil, false
-	//}
-	//sig := packet[:32]
-	//data := packet[32:]
-	//
-	//mac := hmac.New(sha256.New, hmacKey)
-	//mac.Write(data)
-	//expected := mac.Sum(nil)
-	//if hmac.Equal(sig, expected) {
-	//	return data, true
-	//}
-	//return nil, false
+// verify checks whether the relay packet has been signed with the given key.
+func verify(key []byte, pkt RelayPacket) bool {
+	if len(key) == 0 || len(pkt.Signature) != sha256.Size {
+		return false
+	}
+
+	signature := pkt.Signature
+	pkt.Signature = nil
+	data, err := json.Marshal(pkt)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hmac.Equal(signature, mac.Sum(nil))
 }
 
 func generateSelfSigned() tls.Certificate {
-	// For development only. Replace with proper TLS cert in production.
+	// For development only. Configure a real certificate in production, see
+	// WithTLS and WithRelayTLS.
 	cert, _ := tls.X509KeyPair(devCertPEM, devKeyPEM)
 	return cert
 }
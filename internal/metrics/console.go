@@ -22,8 +22,26 @@ var (
 			Name: "gladiator_websocket_connection_errors",
 			Help: "Number of connection errors",
 		})
+
+	LoginFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gladiator_login_failures_total",
+			Help: "Number of rejected sign-in attempts",
+		}, []string{"reason"})
+
+	LoginLockouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gladiator_login_lockouts_total",
+			Help: "Number of temporary lockouts caused by repeated sign-in failures",
+		}, []string{"scope"})
+
+	ReapedRooms = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gladiator_reaped_rooms_total",
+			Help: "Number of abandoned game rooms closed by the console",
+		}, []string{"reason"})
 )
 
 func InitConsole() {
-	prometheus.MustRegister(Uptime, ConnectionErrs)
+	prometheus.MustRegister(Uptime, ConnectionErrs, LoginFailures, LoginLockouts, ReapedRooms)
 }
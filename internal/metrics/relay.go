@@ -29,6 +29,14 @@ var (
 			Help: "Current number of connected peers",
 		})
 
+	PacketDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gladiator_relay_packets_dropped_total",
+			Help: "Total number of packets dropped by the relay",
+		},
+		[]string{"reason"},
+	)
+
 	PeersInRoom = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "gladiator_relay_peers_in_room",
@@ -39,5 +47,5 @@ var (
 )
 
 func InitRelay() {
-	prometheus.MustRegister(PacketIn, PacketOut, ActiveRooms, ConnectedPeers, PeersInRoom)
+	prometheus.MustRegister(PacketIn, PacketOut, PacketDropped, ActiveRooms, ConnectedPeers, PeersInRoom)
 }
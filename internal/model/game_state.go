@@ -5,3 +5,7 @@ const (
 	GameStateCreating byte = 1
 	GameStateStarted  byte = 2
 )
+
+// MaxGamePlayers is the number of the players the game supports in one game
+// room, the host included.
+const MaxGamePlayers = 4
@@ -8,6 +8,11 @@ type WellKnown struct {
 	Addr            string `json:"consoleServerAddr"`
 	RelayServerAddr string `json:"relayServerAddr,omitempty"`
 
+	// RelayServerFingerprint is the SHA-256 fingerprint of the relay server
+	// certificate. Backends pin it, unless they verify the certificate
+	// against a configured CA.
+	RelayServerFingerprint string `json:"relayServerFingerprint,omitempty"`
+
 	CallerIP string `json:"callerIP,omitempty"`
 }
 
@@ -16,7 +16,7 @@ const (
 	SupportedRealm = "lobby-" + ProtoVersion
 )
 
-func Connect(ctx context.Context, wsURL string, user User) (*websocket.Conn, error) {
+func Connect(ctx context.Context, wsURL string, user User, token string) (*websocket.Conn, error) {
 	// Parse the provided signaling server URL
 	u, err := url.Parse(wsURL)
 	if err != nil {
@@ -26,7 +26,6 @@ func Connect(ctx context.Context, wsURL string, user User) (*websocket.Conn, err
 	// Set query parameters.
 	v := u.Query()
 	v.Set("userID", user.ID())
-	v.Set("channelName", "DISPEL")
 	u.RawQuery = v.Encode()
 
 	// Encode the URL to the WebSocket with the query parameters.
@@ -39,9 +38,7 @@ func Connect(ctx context.Context, wsURL string, user User) (*websocket.Conn, err
 
 	headers := http.Header{}
 	headers.Set("X-Version", ProtoVersion)
-
-	// TODO: Add HTTP Authorization header with bearer token
-	// headers.Set("Authorization", "Bearer {}")
+	headers.Set("Authorization", "Bearer "+token)
 
 	// Connect to the signaling server and return it.
 	ws, _, err := websocket.Dial(ctx, u.String(), &websocket.DialOptions{
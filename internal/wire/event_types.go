@@ -19,6 +19,13 @@ const (
 	RTCOffer
 	RTCAnswer
 	RTCICECandidate
+	SystemMessage
+	JoinChannel
+	JoinedChannel
+	JoinChannelRejected
+	PrivateMessage
+	PrivateMessageFailed
+	DrainBackend
 )
 
 func (e EventType) String() string {
@@ -53,6 +60,20 @@ func (e EventType) String() string {
 		return "RTCAnswer"
 	case RTCICECandidate:
 		return "RTCICECandidate"
+	case SystemMessage:
+		return "SystemMessage"
+	case JoinChannel:
+		return "JoinChannel"
+	case JoinedChannel:
+		return "JoinedChannel"
+	case JoinChannelRejected:
+		return "JoinChannelRejected"
+	case PrivateMessage:
+		return "PrivateMessage"
+	case PrivateMessageFailed:
+		return "PrivateMessageFailed"
+	case DrainBackend:
+		return "DrainBackend"
 	default:
 		return "Unknown"
 	}
@@ -2,6 +2,7 @@ package wire
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pion/webrtc/v4"
 )
@@ -40,12 +41,49 @@ type User struct {
 	UserID   int64  `json:"userID"`
 	Username string `json:"username"`
 	Version  string `json:"version"`
+	Role     Role   `json:"role,omitempty"`
 }
 
 func (u *User) ID() string {
 	return fmt.Sprint(u.UserID)
 }
 
+// Role describes which moderation actions the user is allowed to perform.
+type Role string
+
+const (
+	RolePlayer    Role = "player"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+func (r Role) level() int {
+	switch r {
+	case RoleAdmin:
+		return 2
+	case RoleModerator:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Includes reports whether the role grants at least the same privileges as
+// the other one, e.g. the admin can do everything the moderator can.
+func (r Role) Includes(other Role) bool {
+	return r.level() >= other.level()
+}
+
+// Valid reports whether the role is one of the known roles.
+func (r Role) Valid() bool {
+	switch r {
+	case RolePlayer, RoleModerator, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
 type Character struct {
 	CharacterID int64 `json:"characterID"`
 	ClassType   byte  `json:"classType"`
@@ -68,3 +106,40 @@ type ChatMessage struct {
 	User string
 	Text string
 }
+
+// Whisper is a private message sent between two users of the lobby, who are
+// identified by their usernames.
+type Whisper struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+// ChannelJoin is sent by the user who wants to switch to another channel.
+type ChannelJoin struct {
+	Channel  string `json:"channel"`
+	Password string `json:"password,omitempty"`
+}
+
+// ChannelRoster lists the players present in the channel, which the user has
+// just joined.
+type ChannelRoster struct {
+	Channel string   `json:"channel"`
+	Players []Player `json:"players"`
+
+	// MOTD is the message of the day shown after entering the channel.
+	MOTD string `json:"motd,omitempty"`
+}
+
+// ChannelRejection explains why the user could not join the channel.
+type ChannelRejection struct {
+	Channel string `json:"channel"`
+	Reason  string `json:"reason"`
+}
+
+// Drain asks the backend to warn its players and shut down, once the
+// countdown ends.
+type Drain struct {
+	Countdown time.Duration `json:"countdown"`
+	Reason    string        `json:"reason,omitempty"`
+}
@@ -117,6 +117,7 @@ func NewApp(version, commit, buildDate string) {
 		action.BackendCommand(),
 		action.ServeCommand(version),
 		action.TurnCommand(),
+		action.AdminCommand(),
 	)
 	if guiCmd := action.GUICommand(app.Version); guiCmd != nil {
 		app.Commands = append(app.Commands, guiCmd)